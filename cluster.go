@@ -0,0 +1,208 @@
+package dbr
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"sync/atomic"
+)
+
+// Balancer chooses a replica for read queries
+type Balancer interface {
+	// Next returns index of replica to use, n is the number of replicas
+	Next(n int) int
+}
+
+// BalancerFunc is an adapter to allow the use of ordinary functions as Balancer
+type BalancerFunc func(n int) int
+
+// Next implements Balancer interface
+func (f BalancerFunc) Next(n int) int {
+	return f(n)
+}
+
+type roundRobinBalancer struct {
+	counter uint64
+}
+
+func (b *roundRobinBalancer) Next(n int) int {
+	return int((atomic.AddUint64(&b.counter, 1) - 1) % uint64(n))
+}
+
+// RoundRobinBalancer creates a Balancer which chooses replicas in turn
+func RoundRobinBalancer() Balancer {
+	return &roundRobinBalancer{}
+}
+
+// RandomBalancer creates a Balancer which chooses random replica
+func RandomBalancer() Balancer {
+	return BalancerFunc(rand.Intn)
+}
+
+var defaultBalancer = RandomBalancer()
+
+// Cluster is a primary Connection with a set of read replicas.
+// Sessions of the cluster run Select on a replica chosen by Balancer,
+// all other statements and transactions are run on the primary.
+type Cluster struct {
+	Primary  *Connection
+	Replicas []*Connection
+	Balancer Balancer
+}
+
+// OpenCluster instantiates a Cluster for the primary and replicas dsn
+func OpenCluster(driver string, log EventReceiver, primary string, replicas ...string) (*Cluster, error) {
+	conn, err := Open(driver, primary, log)
+	if err != nil {
+		return nil, err
+	}
+	cluster := &Cluster{
+		Primary:  conn,
+		Balancer: RoundRobinBalancer(),
+	}
+	for _, dsn := range replicas {
+		conn, err := Open(driver, dsn, log)
+		if err != nil {
+			cluster.Close()
+			return nil, err
+		}
+		cluster.Replicas = append(cluster.Replicas, conn)
+	}
+	return cluster, nil
+}
+
+// NewSession instantiates a Session for the Cluster
+func (c *Cluster) NewSession(log EventReceiver) *Session {
+	return c.NewSessionContext(context.Background(), log)
+}
+
+// NewSessionContext instantiates a Session with context for the Cluster
+func (c *Cluster) NewSessionContext(ctx context.Context, log EventReceiver) *Session {
+	sess := c.Primary.NewSessionContext(ctx, log)
+	sess.EventReceiver = withNode(sess.EventReceiver, "primary")
+	sess.cluster = c
+	return sess
+}
+
+// Close closes the primary and all replicas
+func (c *Cluster) Close() error {
+	err := c.Primary.Close()
+	for _, replica := range c.Replicas {
+		if e := replica.Close(); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// Primary forks the session, so that its reads are run on the primary too.
+// It is useful to read your own writes.
+func (sess *Session) Primary() *Session {
+	s := sess.NewSession(nil)
+	s.usePrimary = true
+	return s
+}
+
+// reader returns runner for read queries, it is a replica for cluster sessions
+func (sess *Session) reader() (runner, EventReceiver) {
+	if sess.cluster == nil || sess.usePrimary || len(sess.cluster.Replicas) == 0 {
+		return sess, sess.EventReceiver
+	}
+
+	balancer := sess.cluster.Balancer
+	if balancer == nil {
+		balancer = defaultBalancer
+	}
+	i := balancer.Next(len(sess.cluster.Replicas))
+	replica := &Session{
+		Connection:    sess.cluster.Replicas[i],
+		EventReceiver: withNode(sess.EventReceiver, "replica:"+strconv.Itoa(i)),
+		ctx:           sess.ctx,
+		ParamMode:     sess.ParamMode,
+	}
+	return replica, replica.EventReceiver
+}
+
+// usePrimary switches select of a cluster session from a replica to the primary,
+// it is used for locking reads, which can't be run on read-only replicas
+func (b *selectBuilder) usePrimary() {
+	if b.sess != nil {
+		b.runner, b.EventReceiver = b.sess, b.sess.EventReceiver
+	}
+}
+
+// nodeReceiver adds node kv to all events of the wrapped EventReceiver
+type nodeReceiver struct {
+	EventReceiver
+	node string
+}
+
+func withNode(log EventReceiver, node string) EventReceiver {
+	if r, ok := log.(*nodeReceiver); ok {
+		log = r.EventReceiver
+	}
+	return &nodeReceiver{EventReceiver: log, node: node}
+}
+
+func (r *nodeReceiver) kvs(kvs map[string]string) map[string]string {
+	m := make(map[string]string, len(kvs)+1)
+	for k, v := range kvs {
+		m[k] = v
+	}
+	m["node"] = r.node
+	return m
+}
+
+// Event receives a simple notification when various events occur
+func (r *nodeReceiver) Event(eventName string) {
+	r.EventReceiver.EventKv(eventName, r.kvs(nil))
+}
+
+// EventKv receives a notification when various events occur along with
+// optional key/value data
+func (r *nodeReceiver) EventKv(eventName string, kvs map[string]string) {
+	r.EventReceiver.EventKv(eventName, r.kvs(kvs))
+}
+
+// EventErr receives a notification of an error if one occurs
+func (r *nodeReceiver) EventErr(eventName string, err error) error {
+	return r.EventReceiver.EventErrKv(eventName, err, r.kvs(nil))
+}
+
+// EventErrKv receives a notification of an error if one occurs along with
+// optional key/value data
+func (r *nodeReceiver) EventErrKv(eventName string, err error, kvs map[string]string) error {
+	return r.EventReceiver.EventErrKv(eventName, err, r.kvs(kvs))
+}
+
+// Timing receives the time an event took to happen
+func (r *nodeReceiver) Timing(eventName string, nanoseconds int64) {
+	r.EventReceiver.TimingKv(eventName, nanoseconds, r.kvs(nil))
+}
+
+// TimingKv receives the time an event took to happen along with optional key/value data
+func (r *nodeReceiver) TimingKv(eventName string, nanoseconds int64, kvs map[string]string) {
+	r.EventReceiver.TimingKv(eventName, nanoseconds, r.kvs(kvs))
+}
+
+// SpanStart starts span of the wrapped EventReceiver if it implements TracingEventReceiver
+func (r *nodeReceiver) SpanStart(ctx context.Context, eventName, query string) context.Context {
+	if t, ok := r.EventReceiver.(TracingEventReceiver); ok {
+		return t.SpanStart(ctx, eventName, query)
+	}
+	return ctx
+}
+
+// SpanError reports error to the wrapped EventReceiver if it implements TracingEventReceiver
+func (r *nodeReceiver) SpanError(ctx context.Context, err error) {
+	if t, ok := r.EventReceiver.(TracingEventReceiver); ok {
+		t.SpanError(ctx, err)
+	}
+}
+
+// SpanFinish finishes span of the wrapped EventReceiver if it implements TracingEventReceiver
+func (r *nodeReceiver) SpanFinish(ctx context.Context) {
+	if t, ok := r.EventReceiver.(TracingEventReceiver); ok {
+		t.SpanFinish(ctx)
+	}
+}
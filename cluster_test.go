@@ -0,0 +1,104 @@
+package dbr
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClusterMock(t *testing.T, replicas int, log EventReceiver) (*Cluster, []sqlmock.Sqlmock) {
+	var mocks []sqlmock.Sqlmock
+	newConn := func() *Connection {
+		db, m, err := sqlmock.New()
+		assert.NoError(t, err)
+		mocks = append(mocks, m)
+		return &Connection{DBConn: db, Dialect: dialect.MySQL, EventReceiver: log}
+	}
+	cluster := &Cluster{
+		Primary:  newConn(),
+		Balancer: RoundRobinBalancer(),
+	}
+	for i := 0; i < replicas; i++ {
+		cluster.Replicas = append(cluster.Replicas, newConn())
+	}
+	return cluster, mocks
+}
+
+func TestClusterRouting(t *testing.T) {
+	recv := &testEventReceiver{}
+	cluster, mocks := newClusterMock(t, 2, recv)
+	primary, replica0, replica1 := mocks[0], mocks[1], mocks[2]
+	sess := cluster.NewSession(nil)
+
+	replica0.ExpectQuery("SELECT id FROM people").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	replica1.ExpectQuery("SELECT id FROM people").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	primary.ExpectExec("INSERT INTO `people`").WillReturnResult(sqlmock.NewResult(3, 1))
+	primary.ExpectQuery("SELECT id FROM people").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	primary.ExpectQuery("SELECT id FROM people FOR UPDATE").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	primary.ExpectQuery("SELECT id FROM people SKIP LOCKED").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	primary.ExpectBegin()
+	primary.ExpectQuery("SELECT id FROM people").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	primary.ExpectCommit()
+
+	id, err := sess.Select("id").From("people").ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, id)
+	assert.Equal(t, map[string]string{"sql": "SELECT id FROM people", "node": "replica:0"}, recv.kvs[0])
+
+	id, err = sess.SelectBySql("SELECT id FROM people").ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, id)
+	assert.Equal(t, "replica:1", recv.kvs[1]["node"])
+
+	_, err = sess.InsertInto("people").Columns("id").Values(3).Exec()
+	assert.NoError(t, err)
+	assert.Equal(t, "primary", recv.kvs[2]["node"])
+
+	id, err = sess.Primary().Select("id").From("people").ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, id)
+	assert.Equal(t, "primary", recv.kvs[3]["node"])
+
+	// locking reads are run on the primary
+	id, err = sess.Select("id").From("people").ForUpdate().ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, id)
+	assert.Equal(t, "primary", recv.kvs[4]["node"])
+
+	id, err = sess.Select("id").From("people").SkipLocked().ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, id)
+	assert.Equal(t, "primary", recv.kvs[5]["node"])
+
+	tx, err := sess.Begin()
+	assert.NoError(t, err)
+	id, err = tx.Select("id").From("people").ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, id)
+	assert.NoError(t, tx.Commit())
+
+	for _, m := range mocks {
+		assert.NoError(t, m.ExpectationsWereMet())
+	}
+}
+
+func TestClusterWithoutReplicas(t *testing.T) {
+	cluster, mocks := newClusterMock(t, 0, nullReceiver)
+	mocks[0].ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+	id, err := cluster.NewSession(nil).SelectBySql("SELECT 1").ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, id)
+	assert.NoError(t, mocks[0].ExpectationsWereMet())
+}
+
+func TestRoundRobinBalancer(t *testing.T) {
+	b := RoundRobinBalancer()
+	var got []int
+	for i := 0; i < 5; i++ {
+		got = append(got, b.Next(3))
+	}
+	assert.Equal(t, []int{0, 1, 2, 0, 1}, got)
+}
@@ -0,0 +1,161 @@
+package dbr
+
+// CompoundStmt builds set operations `UNION`, `INTERSECT` and `EXCEPT`
+type CompoundStmt interface {
+	Builder
+
+	OrderAsc(col string) CompoundStmt
+	OrderDesc(col string) CompoundStmt
+	Limit(n uint64) CompoundStmt
+	Offset(n uint64) CompoundStmt
+	As(alias string) Builder
+}
+
+type compound struct {
+	op      string
+	all     bool
+	builder []Builder
+
+	Order       []Builder
+	LimitCount  int64
+	OffsetCount int64
+}
+
+func createCompound(op string, all bool, builder []Builder) *compound {
+	return &compound{
+		op:          op,
+		all:         all,
+		builder:     builder,
+		LimitCount:  -1,
+		OffsetCount: -1,
+	}
+}
+
+// Union builds "UNION ..."
+func Union(builder ...Builder) CompoundStmt {
+	return createCompound("UNION", false, builder)
+}
+
+// UnionAll builds "UNION ALL ..."
+func UnionAll(builder ...Builder) CompoundStmt {
+	return createCompound("UNION", true, builder)
+}
+
+// Intersect builds "INTERSECT ..."
+func Intersect(builder ...Builder) CompoundStmt {
+	return createCompound("INTERSECT", false, builder)
+}
+
+// IntersectAll builds "INTERSECT ALL ..."
+func IntersectAll(builder ...Builder) CompoundStmt {
+	return createCompound("INTERSECT", true, builder)
+}
+
+// Except builds "EXCEPT ..."
+func Except(builder ...Builder) CompoundStmt {
+	return createCompound("EXCEPT", false, builder)
+}
+
+// ExceptAll builds "EXCEPT ALL ..."
+func ExceptAll(builder ...Builder) CompoundStmt {
+	return createCompound("EXCEPT", true, builder)
+}
+
+func (u *compound) Build(d Dialect, buf Buffer) error {
+	op := u.keyword(d)
+	if len(op) == 0 {
+		return ErrNotSupported
+	}
+	for i, b := range u.builder {
+		if i > 0 {
+			buf.WriteString(" ")
+			buf.WriteString(op)
+			buf.WriteString(" ")
+		}
+		err := u.buildMember(d, buf, b)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(u.Order) > 0 {
+		buf.WriteString(" ORDER BY ")
+		for i, order := range u.Order {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			err := order.Build(d, buf)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if u.LimitCount >= 0 {
+		buf.WriteString(" ")
+		buf.WriteString(d.Limit(u.OffsetCount, u.LimitCount))
+	}
+	return nil
+}
+
+// buildMember writes b in parentheses, dialects without parenthesized members get plain SELECT,
+// other members like nested compounds or SELECT with ORDER BY are selected from a subquery there
+func (u *compound) buildMember(d Dialect, buf Buffer, b Builder) error {
+	if d.SupportsCompoundParens() {
+		buf.WriteString(placeholder)
+		buf.WriteValue(b)
+		return nil
+	}
+	if stmt, ok := b.(*selectStmt); ok && stmt.raw.Query == "" && len(stmt.CTE) == 0 &&
+		len(stmt.Order) == 0 && stmt.LimitCount < 0 {
+		return stmt.Build(d, buf)
+	}
+	buf.WriteString("SELECT * FROM (")
+	buf.WriteString(placeholder)
+	// BuildFunc hides SelectStmt and compound, which are wrapped in parentheses otherwise
+	buf.WriteValue(BuildFunc(b.Build))
+	buf.WriteString(")")
+	return nil
+}
+
+// keyword returns keyword of the set operation, it is empty if the dialect doesn't support it
+func (u *compound) keyword(d Dialect) string {
+	switch u.op {
+	case "INTERSECT":
+		return d.Intersect(u.all)
+	case "EXCEPT":
+		return d.Except(u.all)
+	}
+	if u.all {
+		return u.op + " ALL"
+	}
+	return u.op
+}
+
+// OrderAsc specifies columns of the result for ordering in asc direction
+func (u *compound) OrderAsc(col string) CompoundStmt {
+	u.Order = append(u.Order, order(col, asc))
+	return u
+}
+
+// OrderDesc specifies columns of the result for ordering in desc direction
+func (u *compound) OrderDesc(col string) CompoundStmt {
+	u.Order = append(u.Order, order(col, desc))
+	return u
+}
+
+// Limit adds LIMIT
+func (u *compound) Limit(n uint64) CompoundStmt {
+	u.LimitCount = int64(n)
+	return u
+}
+
+// Offset adds OFFSET, works only if LIMIT is set
+func (u *compound) Offset(n uint64) CompoundStmt {
+	u.OffsetCount = int64(n)
+	return u
+}
+
+func (u *compound) As(alias string) Builder {
+	return as(u, alias)
+}
@@ -0,0 +1,241 @@
+package dbr
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"time"
+)
+
+// CompoundBuilder builds and loads results of set operations `UNION`, `INTERSECT` and `EXCEPT`
+type CompoundBuilder interface {
+	Builder
+	EventReceiver
+	loader
+	typesLoader
+
+	As(alias string) Builder
+	InTimezone(loc *time.Location) CompoundBuilder
+	Limit(n uint64) CompoundBuilder
+	Offset(n uint64) CompoundBuilder
+	OrderAsc(col string) CompoundBuilder
+	OrderDesc(col string) CompoundBuilder
+	GetRows() (*sql.Rows, error)
+	GetRowsContext(context.Context) (*sql.Rows, error)
+	Iterate() (Iterator, error)
+	IterateContext(ctx context.Context) (Iterator, error)
+}
+
+type compoundBuilder struct {
+	runner
+	EventReceiver
+
+	Dialect  Dialect
+	compound CompoundStmt
+	timezone *time.Location
+	ctx      context.Context
+}
+
+// Compound creates a CompoundBuilder for stmt, for Cluster sessions it is run on a replica
+func (sess *Session) Compound(stmt CompoundStmt) CompoundBuilder {
+	runner, log := sess.reader()
+	return &compoundBuilder{
+		runner:        runner,
+		EventReceiver: log,
+		Dialect:       sess.Dialect,
+		compound:      stmt,
+		ctx:           sess.ctx,
+	}
+}
+
+// Compound creates a CompoundBuilder for stmt
+func (tx *Tx) Compound(stmt CompoundStmt) CompoundBuilder {
+	return &compoundBuilder{
+		runner:        tx,
+		EventReceiver: tx.EventReceiver,
+		Dialect:       tx.Dialect,
+		compound:      stmt,
+		ctx:           tx.ctx,
+	}
+}
+
+func (b *compoundBuilder) Build(d Dialect, buf Buffer) error {
+	return b.compound.Build(d, buf)
+}
+
+// As creates alias for compound statement
+func (b *compoundBuilder) As(alias string) Builder {
+	return b.compound.As(alias)
+}
+
+// InTimezone all time.Time fields in the result will be returned with the specified location.
+func (b *compoundBuilder) InTimezone(loc *time.Location) CompoundBuilder {
+	b.timezone = loc
+	return b
+}
+
+// Limit adds LIMIT
+func (b *compoundBuilder) Limit(n uint64) CompoundBuilder {
+	b.compound.Limit(n)
+	return b
+}
+
+// Offset adds OFFSET, works only if LIMIT is set
+func (b *compoundBuilder) Offset(n uint64) CompoundBuilder {
+	b.compound.Offset(n)
+	return b
+}
+
+// OrderAsc specifies columns of the result for ordering in asc direction
+func (b *compoundBuilder) OrderAsc(col string) CompoundBuilder {
+	b.compound.OrderAsc(col)
+	return b
+}
+
+// OrderDesc specifies columns of the result for ordering in desc direction
+func (b *compoundBuilder) OrderDesc(col string) CompoundBuilder {
+	b.compound.OrderDesc(col)
+	return b
+}
+
+func (b *compoundBuilder) changeTimezone(value reflect.Value) {
+	inTimezone(b.timezone, value)
+}
+
+// load runs the query and changes timezone of the loaded values, it returns ErrNotFound if mustExist and there is no result
+func (b *compoundBuilder) load(ctx context.Context, value interface{}, mustExist bool) (int, error) {
+	count, err := query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+	if err != nil {
+		return count, err
+	}
+	if mustExist && count == 0 {
+		return 0, ErrNotFound
+	}
+	if b.timezone != nil {
+		b.changeTimezone(reflect.ValueOf(value))
+	}
+	return count, nil
+}
+
+// Load loads any value from query result with background context
+func (b *compoundBuilder) Load(value interface{}) (int, error) {
+	return b.LoadContext(b.ctx, value)
+}
+
+// LoadContext loads any value from query result
+func (b *compoundBuilder) LoadContext(ctx context.Context, value interface{}) (int, error) {
+	return b.load(ctx, value, false)
+}
+
+// LoadStruct loads struct from query result with background context, returns ErrNotFound if there is no result
+func (b *compoundBuilder) LoadStruct(value interface{}) error {
+	return b.LoadStructContext(b.ctx, value)
+}
+
+// LoadStructContext loads struct from query result, returns ErrNotFound if there is no result
+func (b *compoundBuilder) LoadStructContext(ctx context.Context, value interface{}) error {
+	_, err := b.load(ctx, value, true)
+	return err
+}
+
+// LoadStructs loads structures from query result with background context
+func (b *compoundBuilder) LoadStructs(value interface{}) (int, error) {
+	return b.LoadStructsContext(b.ctx, value)
+}
+
+// LoadStructsContext loads structures from query result
+func (b *compoundBuilder) LoadStructsContext(ctx context.Context, value interface{}) (int, error) {
+	return b.load(ctx, value, false)
+}
+
+// LoadValue loads any value from query result with background context, returns ErrNotFound if there is no result
+func (b *compoundBuilder) LoadValue(value interface{}) error {
+	return b.LoadValueContext(b.ctx, value)
+}
+
+// LoadValueContext loads any value from query result, returns ErrNotFound if there is no result
+func (b *compoundBuilder) LoadValueContext(ctx context.Context, value interface{}) error {
+	_, err := b.load(ctx, value, true)
+	return err
+}
+
+// LoadValues loads any values from query result with background context
+func (b *compoundBuilder) LoadValues(value interface{}) (int, error) {
+	return b.LoadValuesContext(b.ctx, value)
+}
+
+// LoadValuesContext loads any values from query result
+func (b *compoundBuilder) LoadValuesContext(ctx context.Context, value interface{}) (int, error) {
+	return b.load(ctx, value, false)
+}
+
+// GetRows returns sql.Rows from query result.
+func (b *compoundBuilder) GetRows() (*sql.Rows, error) {
+	return b.GetRowsContext(b.ctx)
+}
+
+// GetRowsContext returns sql.Rows from query result.
+func (b *compoundBuilder) GetRowsContext(ctx context.Context) (*sql.Rows, error) {
+	rows, _, err := queryRows(ctx, b.runner, b.EventReceiver, b, b.Dialect)
+	return rows, err
+}
+
+// Iterate returns Iterator over query result with background context
+func (b *compoundBuilder) Iterate() (Iterator, error) {
+	return b.IterateContext(b.ctx)
+}
+
+// IterateContext returns Iterator over query result.
+// Timing and tracing events are emitted when the iteration finishes.
+func (b *compoundBuilder) IterateContext(ctx context.Context) (Iterator, error) {
+	it, err := iterate(ctx, b.runner, b.EventReceiver, b, b.Dialect)
+	if err != nil {
+		return nil, err
+	}
+	if b.timezone != nil {
+		it.afterScan = b.changeTimezone
+	}
+	return it, nil
+}
+
+// ReturnInt64 executes the CompoundStmt and returns the value as an int64
+func (b *compoundBuilder) ReturnInt64() (int64, error) {
+	var v int64
+	err := b.LoadValue(&v)
+	return v, err
+}
+
+// ReturnInt64s executes the CompoundStmt and returns the value as a slice of int64s
+func (b *compoundBuilder) ReturnInt64s() ([]int64, error) {
+	var v []int64
+	_, err := b.LoadValues(&v)
+	return v, err
+}
+
+// ReturnUint64 executes the CompoundStmt and returns the value as an uint64
+func (b *compoundBuilder) ReturnUint64() (uint64, error) {
+	var v uint64
+	err := b.LoadValue(&v)
+	return v, err
+}
+
+// ReturnUint64s executes the CompoundStmt and returns the value as a slice of uint64s
+func (b *compoundBuilder) ReturnUint64s() ([]uint64, error) {
+	var v []uint64
+	_, err := b.LoadValues(&v)
+	return v, err
+}
+
+// ReturnString executes the CompoundStmt and returns the value as a string
+func (b *compoundBuilder) ReturnString() (string, error) {
+	var v string
+	err := b.LoadValue(&v)
+	return v, err
+}
+
+// ReturnStrings executes the CompoundStmt and returns the value as a slice of strings
+func (b *compoundBuilder) ReturnStrings() ([]string, error) {
+	var v []string
+	_, err := b.LoadValues(&v)
+	return v, err
+}
@@ -0,0 +1,161 @@
+package dbr
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompound(t *testing.T) {
+	for _, test := range []struct {
+		builder Builder
+		query   string
+	}{
+		{
+			builder: Union(Select("a").From("t1"), Select("a").From("t2")),
+			query:   `(SELECT a FROM t1) UNION (SELECT a FROM t2)`,
+		},
+		{
+			builder: IntersectAll(Select("a").From("t1"), Select("a").From("t2")).OrderDesc("a").Limit(10).Offset(5),
+			query:   `(SELECT a FROM t1) INTERSECT ALL (SELECT a FROM t2) ORDER BY a DESC LIMIT 10 OFFSET 5`,
+		},
+		{
+			builder: Except(Select("a").From("t1"), Intersect(Select("a").From("t2"), Select("a").From("t3"))).OrderAsc("a"),
+			query:   `(SELECT a FROM t1) EXCEPT ((SELECT a FROM t2) INTERSECT (SELECT a FROM t3)) ORDER BY a ASC`,
+		},
+		{
+			builder: Select("*").From(ExceptAll(Select("a").From("t1"), Select("a").From("t2")).Limit(1).As("u")),
+			query:   `SELECT * FROM ((SELECT a FROM t1) EXCEPT ALL (SELECT a FROM t2) LIMIT 1) AS "u"`,
+		},
+	} {
+		buf := NewBuffer()
+		err := test.builder.Build(dialect.PostgreSQL, buf)
+		assert.NoError(t, err)
+		query, err := InterpolateForDialect(buf.String(), buf.Value(), dialect.PostgreSQL)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, query)
+	}
+}
+
+func TestCompoundBuilder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: nullReceiver}
+	sess := conn.NewSession(nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`(SELECT id FROM a WHERE ("x" = 1)) INTERSECT (SELECT id FROM b) ORDER BY id ASC LIMIT 2`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+
+	ids, err := sess.Compound(Intersect(
+		Select("id").From("a").Where(Eq("x", 1)),
+		Select("id").From("b"),
+	)).OrderAsc("id").Limit(2).ReturnInt64s()
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, ids)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`(SELECT created_at FROM a) UNION ALL (SELECT created_at FROM b) LIMIT 1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+	mock.ExpectQuery(regexp.QuoteMeta(`(SELECT created_at FROM a) UNION (SELECT created_at FROM b)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+	tx, err := sess.Begin()
+	assert.NoError(t, err)
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	var createdAt time.Time
+	err = tx.Compound(UnionAll(Select("created_at").From("a"), Select("created_at").From("b"))).
+		Limit(1).InTimezone(loc).LoadValue(&createdAt)
+	assert.NoError(t, err)
+	assert.Equal(t, loc, createdAt.Location())
+
+	err = tx.Compound(Union(Select("created_at").From("a"), Select("created_at").From("b"))).LoadValue(&createdAt)
+	assert.Equal(t, ErrNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompoundNotSupported(t *testing.T) {
+	for _, test := range []struct {
+		builder Builder
+		dialect Dialect
+	}{
+		{Intersect(Select("a").From("t1"), Select("a").From("t2")), dialect.MySQL},
+		{Except(Select("a").From("t1"), Select("a").From("t2")), dialect.MySQL8},
+		{IntersectAll(Select("a").From("t1"), Select("a").From("t2")), dialect.SQLite3},
+		{ExceptAll(Select("a").From("t1"), Select("a").From("t2")), dialect.ClickHouse},
+	} {
+		err := test.builder.Build(test.dialect, NewBuffer())
+		assert.Equal(t, ErrNotSupported, err)
+	}
+
+	// subqueries are built on interpolation
+	buf := NewBuffer()
+	err := Select("*").From(Except(Select("a").From("t1"), Select("a").From("t2")).As("u")).Build(dialect.MySQL, buf)
+	assert.NoError(t, err)
+	_, err = InterpolateForDialect(buf.String(), buf.Value(), dialect.MySQL)
+	assert.Equal(t, ErrNotSupported, err)
+
+	buf = NewBuffer()
+	err = Except(Select("a").From("t1"), Select("a").From("t2")).Build(dialect.SQLite3, buf)
+	assert.NoError(t, err)
+	err = UnionAll(Select("a").From("t1"), Select("a").From("t2")).Build(dialect.MySQL, buf)
+	assert.NoError(t, err)
+}
+
+func TestCompoundWithoutParens(t *testing.T) {
+	for _, test := range []struct {
+		builder Builder
+		query   string
+	}{
+		{
+			builder: Union(Select("a").From("t1"), Select("a").From("t2").Where(Eq("b", 1))).OrderAsc("a").Limit(1),
+			query:   `SELECT a FROM t1 UNION SELECT a FROM t2 WHERE ("b" = 1) ORDER BY a ASC LIMIT 1`,
+		},
+		{
+			builder: Except(Select("a").From("t1"), Intersect(Select("a").From("t2"), Select("a").From("t3"))),
+			query:   `SELECT a FROM t1 EXCEPT SELECT * FROM (SELECT a FROM t2 INTERSECT SELECT a FROM t3)`,
+		},
+		{
+			builder: UnionAll(Select("a").From("t1").OrderDesc("a").Limit(1), SelectBySql("SELECT a FROM t2")),
+			query:   `SELECT * FROM (SELECT a FROM t1 ORDER BY a DESC LIMIT 1) UNION ALL SELECT * FROM (SELECT a FROM t2)`,
+		},
+	} {
+		buf := NewBuffer()
+		err := test.builder.Build(dialect.SQLite3, buf)
+		assert.NoError(t, err)
+		query, err := InterpolateForDialect(buf.String(), buf.Value(), dialect.SQLite3)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, query)
+	}
+}
+
+func TestCompoundSQLite(t *testing.T) {
+	sess := sqlite3Session
+	reset(sess)
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := sess.InsertInto("dbr_people").Columns("name", "email").Values(name, name+"@example.com").Exec()
+		assert.NoError(t, err)
+	}
+
+	var names []string
+	_, err := sess.Compound(Intersect(
+		Select("name").From("dbr_people"),
+		Select("name").From("dbr_people").Where(Neq("name", "a")),
+	)).OrderDesc("name").Load(&names)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c", "b"}, names)
+
+	names = nil
+	_, err = sess.Compound(Except(
+		Select("name").From("dbr_people"),
+		Union(
+			Select("name").From("dbr_people").Where(Eq("name", "a")),
+			Select("name").From("dbr_people").OrderDesc("name").Limit(1),
+		),
+	)).Load(&names)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, names)
+}
@@ -1,6 +1,9 @@
 package dbr
 
-import "reflect"
+import (
+	"reflect"
+	"strings"
+)
 
 func buildCond(d Dialect, buf Buffer, pred string, cond ...Builder) error {
 	for i, c := range cond {
@@ -117,3 +120,283 @@ func Lte(column string, value interface{}) Builder {
 		return buildCmp(d, buf, "<=", column, value)
 	})
 }
+
+// Not is `NOT`.
+func Not(cond Builder) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		buf.WriteString("NOT (")
+		err := cond.Build(d, buf)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(")")
+		return nil
+	})
+}
+
+// IsNull is `IS NULL`.
+func IsNull(column string) Builder {
+	return Eq(column, nil)
+}
+
+// IsNotNull is `IS NOT NULL`.
+func IsNotNull(column string) Builder {
+	return Neq(column, nil)
+}
+
+// In is `IN` with a subquery.
+func In(column string, query SelectStmt) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildCmp(d, buf, "IN", column, query)
+	})
+}
+
+// Exists is `EXISTS` with a subquery.
+func Exists(query SelectStmt) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		buf.WriteString("EXISTS ")
+		buf.WriteString(placeholder)
+		buf.WriteValue(query)
+		return nil
+	})
+}
+
+// NotExists is `NOT EXISTS` with a subquery.
+func NotExists(query SelectStmt) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		buf.WriteString("NOT EXISTS ")
+		buf.WriteString(placeholder)
+		buf.WriteValue(query)
+		return nil
+	})
+}
+
+func buildBetween(d Dialect, buf Buffer, pred, column string, lower, upper interface{}) error {
+	buf.WriteString(d.QuoteIdent(column))
+	buf.WriteString(" ")
+	buf.WriteString(pred)
+	buf.WriteString(" ")
+	buf.WriteString(placeholder)
+	buf.WriteString(" AND ")
+	buf.WriteString(placeholder)
+
+	buf.WriteValue(lower, upper)
+	return nil
+}
+
+// Between is `BETWEEN`.
+func Between(column string, lower, upper interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildBetween(d, buf, "BETWEEN", column, lower, upper)
+	})
+}
+
+// NotBetween is `NOT BETWEEN`.
+func NotBetween(column string, lower, upper interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildBetween(d, buf, "NOT BETWEEN", column, lower, upper)
+	})
+}
+
+func buildLike(d Dialect, buf Buffer, pred, column string, value interface{}, escape []string) error {
+	err := buildCmp(d, buf, pred, column, value)
+	if err != nil {
+		return err
+	}
+	if len(escape) > 0 {
+		buf.WriteString(" ESCAPE ")
+		buf.WriteString(d.EncodeString(escape[0]))
+	}
+	return nil
+}
+
+// Like is `LIKE`.
+// Optional escape is the character used to escape wildcards in value, see EscapeLike.
+func Like(column string, value interface{}, escape ...string) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildLike(d, buf, "LIKE", column, value, escape)
+	})
+}
+
+// NotLike is `NOT LIKE`.
+// Optional escape is the character used to escape wildcards in value, see EscapeLike.
+func NotLike(column string, value interface{}, escape ...string) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildLike(d, buf, "NOT LIKE", column, value, escape)
+	})
+}
+
+// ILike is case-insensitive `LIKE`.
+// When dialect has no `ILIKE`, it will be translated to `LOWER(column) LIKE LOWER(value)`.
+// Optional escape is the character used to escape wildcards in value, see EscapeLike.
+func ILike(column string, value interface{}, escape ...string) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		if keyword := d.ILike(); keyword != "" {
+			return buildLike(d, buf, keyword, column, value, escape)
+		}
+		buf.WriteString("LOWER(")
+		buf.WriteString(d.QuoteIdent(column))
+		buf.WriteString(") LIKE LOWER(")
+		buf.WriteString(placeholder)
+		buf.WriteString(")")
+		buf.WriteValue(value)
+		if len(escape) > 0 {
+			buf.WriteString(" ESCAPE ")
+			buf.WriteString(d.EncodeString(escape[0]))
+		}
+		return nil
+	})
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes LIKE wildcards in s with backslash,
+// so that user input can be matched literally, e.g.
+// Like("title", "%"+EscapeLike(input)+"%", `\`)
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// cmp is buildCmp as a Builder
+func cmp(pred, column string, value interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildCmp(d, buf, pred, column, value)
+	})
+}
+
+func buildTuple(buf Buffer, n int) {
+	buf.WriteString("(")
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(placeholder)
+	}
+	buf.WriteString(")")
+}
+
+func buildTupleColumn(d Dialect, buf Buffer, column []string) {
+	buf.WriteString("(")
+	for i, col := range column {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(d.QuoteIdent(col))
+	}
+	buf.WriteString(")")
+}
+
+// TupleIn is `(a, b) IN ((?, ?), (?, ?))`.
+// When dialect does not support row values, it will be translated to
+// `((a = ?) AND (b = ?)) OR ((a = ?) AND (b = ?))`.
+func TupleIn(column []string, value [][]interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		if len(column) == 0 {
+			return ErrColumnNotSpecified
+		}
+		for _, tuple := range value {
+			if len(tuple) != len(column) {
+				return ErrInvalidTupleLength
+			}
+		}
+		if len(value) == 0 {
+			buf.WriteString(d.EncodeBool(false))
+			return nil
+		}
+
+		if !d.SupportsRowValues() {
+			or := make([]Builder, len(value))
+			for i, tuple := range value {
+				and := make([]Builder, len(column))
+				for j, col := range column {
+					and[j] = cmp("=", col, tuple[j])
+				}
+				or[i] = And(and...)
+			}
+			return buildCond(d, buf, "OR", or...)
+		}
+
+		buildTupleColumn(d, buf, column)
+		buf.WriteString(" IN (")
+		for i, tuple := range value {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buildTuple(buf, len(tuple))
+			buf.WriteValue(tuple...)
+		}
+		buf.WriteString(")")
+		return nil
+	})
+}
+
+// buildTupleCmp compares tuples lexicographically,
+// strict is the predicate used for all columns except the last one
+// when dialect does not support row values.
+func buildTupleCmp(d Dialect, buf Buffer, pred, strict string, column []string, value []interface{}) error {
+	if len(column) == 0 {
+		return ErrColumnNotSpecified
+	}
+	if len(value) != len(column) {
+		return ErrInvalidTupleLength
+	}
+
+	if !d.SupportsRowValues() {
+		or := make([]Builder, len(column))
+		for i := range column {
+			var and []Builder
+			for j := 0; j < i; j++ {
+				and = append(and, cmp("=", column[j], value[j]))
+			}
+			p := strict
+			if i == len(column)-1 {
+				p = pred
+			}
+			and = append(and, cmp(p, column[i], value[i]))
+			if len(and) == 1 {
+				or[i] = and[0]
+			} else {
+				or[i] = And(and...)
+			}
+		}
+		return buildCond(d, buf, "OR", or...)
+	}
+
+	buildTupleColumn(d, buf, column)
+	buf.WriteString(" ")
+	buf.WriteString(pred)
+	buf.WriteString(" ")
+	buildTuple(buf, len(value))
+	buf.WriteValue(value...)
+	return nil
+}
+
+// TupleGt is `(a, b) > (?, ?)`.
+// When dialect does not support row values, it will be translated to
+// `(a > ?) OR ((a = ?) AND (b > ?))`.
+func TupleGt(column []string, value []interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildTupleCmp(d, buf, ">", ">", column, value)
+	})
+}
+
+// TupleGte is `(a, b) >= (?, ?)`.
+func TupleGte(column []string, value []interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildTupleCmp(d, buf, ">=", ">", column, value)
+	})
+}
+
+// TupleLt is `(a, b) < (?, ?)`.
+func TupleLt(column []string, value []interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildTupleCmp(d, buf, "<", "<", column, value)
+	})
+}
+
+// TupleLte is `(a, b) <= (?, ?)`.
+func TupleLte(column []string, value []interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		return buildTupleCmp(d, buf, "<=", "<", column, value)
+	})
+}
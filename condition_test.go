@@ -93,11 +93,170 @@ func TestCondition(t *testing.T) {
 			query: "(`a` < ?) AND ((`b` > ?) OR (`c` != ?))",
 			value: []interface{}{1, 2, 3},
 		},
+		{
+			cond:  Not(Eq("col", 1)),
+			query: "NOT (`col` = ?)",
+			value: []interface{}{1},
+		},
+		{
+			cond:  IsNull("col"),
+			query: "`col` IS NULL",
+			value: nil,
+		},
+		{
+			cond:  IsNotNull("col"),
+			query: "`col` IS NOT NULL",
+			value: nil,
+		},
+		{
+			cond:  Between("col", 1, 2),
+			query: "`col` BETWEEN ? AND ?",
+			value: []interface{}{1, 2},
+		},
+		{
+			cond:  NotBetween("col", 1, 2),
+			query: "`col` NOT BETWEEN ? AND ?",
+			value: []interface{}{1, 2},
+		},
+		{
+			cond:  Like("col", "a%"),
+			query: "`col` LIKE ?",
+			value: []interface{}{"a%"},
+		},
+		{
+			cond:  NotLike("col", "a!%%", "!"),
+			query: "`col` NOT LIKE ? ESCAPE '!'",
+			value: []interface{}{"a!%%"},
+		},
+		{
+			cond:  ILike("col", "a%", `\`),
+			query: "LOWER(`col`) LIKE LOWER(?) ESCAPE '\\\\'",
+			value: []interface{}{"a%"},
+		},
+	} {
+		buf := NewBuffer()
+		err := test.cond.Build(dialect.MySQL, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, buf.String())
+		assert.Equal(t, test.value, buf.Value())
+	}
+}
+
+func TestConditionSubquery(t *testing.T) {
+	for _, test := range []struct {
+		cond  Builder
+		query string
+	}{
+		{
+			cond:  In("id", Select("user_id").From("orders")),
+			query: "`id` IN (SELECT user_id FROM orders)",
+		},
+		{
+			cond:  Exists(Select("1").From("orders").Where("user_id = users.id")),
+			query: "EXISTS (SELECT 1 FROM orders WHERE (user_id = users.id))",
+		},
+		{
+			cond:  NotExists(Select("1").From("orders").Where(Eq("status", "new"))),
+			query: "NOT EXISTS (SELECT 1 FROM orders WHERE (`status` = 'new'))",
+		},
+	} {
+		buf := NewBuffer()
+		err := test.cond.Build(dialect.MySQL, buf)
+		assert.NoError(t, err)
+		query, err := InterpolateForDialect(buf.String(), buf.Value(), dialect.MySQL)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, query)
+	}
+}
+
+func TestILike(t *testing.T) {
+	for _, test := range []struct {
+		dialect Dialect
+		query   string
+	}{
+		{dialect.PostgreSQL, `"col" ILIKE ?`},
+		{dialect.ClickHouse, "`col` ILIKE ?"},
+		{dialect.MySQL, "LOWER(`col`) LIKE LOWER(?)"},
+		{dialect.SQLite3, `LOWER("col") LIKE LOWER(?)`},
+	} {
+		buf := NewBuffer()
+		err := ILike("col", "a%").Build(test.dialect, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, buf.String())
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, `100\% \_a\\b`, EscapeLike(`100% _a\b`))
+}
+
+// noRowValues is a dialect without row values support
+type noRowValues struct {
+	Dialect
+}
+
+func (noRowValues) SupportsRowValues() bool {
+	return false
+}
+
+func TestTupleCondition(t *testing.T) {
+	columns := []string{"a", "b"}
+	for _, test := range []struct {
+		cond     Builder
+		query    string
+		expanded string
+		value    []interface{}
+	}{
+		{
+			cond:     TupleIn(columns, [][]interface{}{{1, 2}, {3, 4}}),
+			query:    "(`a`, `b`) IN ((?, ?), (?, ?))",
+			expanded: "((`a` = ?) AND (`b` = ?)) OR ((`a` = ?) AND (`b` = ?))",
+			value:    []interface{}{1, 2, 3, 4},
+		},
+		{
+			cond:     TupleIn(columns, nil),
+			query:    "0",
+			expanded: "0",
+		},
+		{
+			cond:     TupleGt(columns, []interface{}{1, 2}),
+			query:    "(`a`, `b`) > (?, ?)",
+			expanded: "(`a` > ?) OR ((`a` = ?) AND (`b` > ?))",
+			value:    []interface{}{1, 2},
+		},
+		{
+			cond:     TupleGte(columns, []interface{}{1, 2}),
+			query:    "(`a`, `b`) >= (?, ?)",
+			expanded: "(`a` > ?) OR ((`a` = ?) AND (`b` >= ?))",
+			value:    []interface{}{1, 2},
+		},
+		{
+			cond:     TupleLt([]string{"a", "b", "c"}, []interface{}{1, 2, 3}),
+			query:    "(`a`, `b`, `c`) < (?, ?, ?)",
+			expanded: "(`a` < ?) OR ((`a` = ?) AND (`b` < ?)) OR ((`a` = ?) AND (`b` = ?) AND (`c` < ?))",
+			value:    []interface{}{1, 2, 3},
+		},
+		{
+			cond:     TupleLte(columns, []interface{}{1, 2}),
+			query:    "(`a`, `b`) <= (?, ?)",
+			expanded: "(`a` < ?) OR ((`a` = ?) AND (`b` <= ?))",
+			value:    []interface{}{1, 2},
+		},
 	} {
 		buf := NewBuffer()
 		err := test.cond.Build(dialect.MySQL, buf)
 		assert.NoError(t, err)
 		assert.Equal(t, test.query, buf.String())
 		assert.Equal(t, test.value, buf.Value())
+
+		buf = NewBuffer()
+		err = test.cond.Build(noRowValues{dialect.MySQL}, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expanded, buf.String())
 	}
+
+	err := TupleIn(columns, [][]interface{}{{1}}).Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrInvalidTupleLength, err)
+	err = TupleGt(columns, []interface{}{1, 2, 3}).Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrInvalidTupleLength, err)
 }
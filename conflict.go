@@ -0,0 +1,190 @@
+package dbr
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ConflictStmt is ` ON CONFLICT ...` part of InsertStmt
+type ConflictStmt interface {
+	Action(column string, action interface{}) ConflictStmt
+	DoNothing() ConflictStmt
+	TargetWhere(query interface{}, value ...interface{}) ConflictStmt
+	Where(query interface{}, value ...interface{}) ConflictStmt
+}
+
+type conflictStmt struct {
+	constraint string
+	column     []string
+	actions    map[string]interface{}
+	doNothing  bool
+	targetCond []Builder
+	cond       []Builder
+}
+
+// Action adds action for column which will do if conflict happens
+func (b *conflictStmt) Action(column string, action interface{}) ConflictStmt {
+	b.actions[column] = action
+	return b
+}
+
+// DoNothing skips rows conflicting with existing ones,
+// MySQL uses `INSERT IGNORE` for it, which also ignores other errors
+func (b *conflictStmt) DoNothing() ConflictStmt {
+	b.doNothing = true
+	return b
+}
+
+// TargetWhere adds a condition to conflict target to match partial unique index,
+// it requires columns target of OnConflictColumns
+func (b *conflictStmt) TargetWhere(query interface{}, value ...interface{}) ConflictStmt {
+	switch query := query.(type) {
+	case string:
+		b.targetCond = append(b.targetCond, Expr(query, value...))
+	case Builder:
+		b.targetCond = append(b.targetCond, query)
+	}
+	return b
+}
+
+// Where adds a condition for rows to be updated on conflict, it can't be used with DoNothing
+func (b *conflictStmt) Where(query interface{}, value ...interface{}) ConflictStmt {
+	switch query := query.(type) {
+	case string:
+		b.cond = append(b.cond, Expr(query, value...))
+	case Builder:
+		b.cond = append(b.cond, query)
+	}
+	return b
+}
+
+// Proposed is reference to proposed value in on conflict clause
+func Proposed(column string) Builder {
+	return BuildFunc(func(d Dialect, b Buffer) error {
+		_, err := b.WriteString(d.Proposed(column))
+		return err
+	})
+}
+
+// buildConflict builds ` ON CONFLICT ...` and `RETURNING ...` parts
+func (b *insertStmt) buildConflict(d Dialect, buf Buffer) error {
+	if b.Conflict != nil {
+		err := b.buildConflictAction(d, buf)
+		if err != nil {
+			return err
+		}
+	}
+	return buildReturning(d, buf, b.ReturnColumn)
+}
+
+func (b *insertStmt) buildConflictAction(d Dialect, buf Buffer) error {
+	c := b.Conflict
+	if !c.doNothing && len(c.actions) == 0 {
+		return ErrNoConflictAction
+	}
+	// only unique index columns can have a predicate, update condition has nothing to filter without update
+	if len(c.targetCond) > 0 && len(c.column) == 0 {
+		return ErrConflictTargetWhere
+	}
+	if c.doNothing && len(c.cond) > 0 {
+		return ErrDoNothingWhere
+	}
+	if (len(c.targetCond) > 0 || len(c.cond) > 0) && !d.SupportsConflictWhere() {
+		return ErrNotSupported
+	}
+	if c.doNothing && d.InsertIgnore() != "" {
+		return nil
+	}
+
+	target := NewBuffer()
+	if len(c.column) > 0 {
+		target.WriteString("(")
+		for i, col := range c.column {
+			if i > 0 {
+				target.WriteString(",")
+			}
+			target.WriteString(d.QuoteIdent(col))
+		}
+		target.WriteString(")")
+	} else if c.constraint != "" {
+		constraint := d.ConflictConstraint(c.constraint)
+		if len(constraint) == 0 {
+			return ErrNotSupported
+		}
+		target.WriteString(constraint)
+	}
+	if len(c.targetCond) > 0 {
+		target.WriteString(" WHERE ")
+		err := buildCond(d, target, "AND", c.targetCond...)
+		if err != nil {
+			return err
+		}
+	}
+
+	var keyword string
+	if c.doNothing {
+		keyword = d.OnConflictDoNothing(target.String())
+	} else {
+		keyword = d.OnConflict(target.String())
+	}
+	if len(keyword) == 0 {
+		if target.String() == "" && !c.doNothing {
+			return ErrNoConflictTarget
+		}
+		return fmt.Errorf("Dialect %s does not support OnConflict", d)
+	}
+	if alias := d.RowAlias(); alias != "" && !c.doNothing {
+		if b.SelectQuery != nil {
+			return ErrNotSupported
+		}
+		buf.WriteString(" AS ")
+		buf.WriteString(alias)
+	}
+	buf.WriteString(" ")
+	buf.WriteString(keyword)
+	buf.WriteValue(target.Value()...)
+	if c.doNothing {
+		return nil
+	}
+
+	buf.WriteString(" ")
+	needComma := false
+	for _, column := range b.conflictColumns() {
+		if v, ok := c.actions[column]; ok {
+			if needComma {
+				buf.WriteString(",")
+			}
+			buf.WriteString(d.QuoteIdent(column))
+			buf.WriteString("=")
+			buf.WriteString(placeholder)
+			buf.WriteValue(v)
+			needComma = true
+		}
+	}
+	if len(c.cond) > 0 {
+		buf.WriteString(" WHERE ")
+		return buildCond(d, buf, "AND", c.cond...)
+	}
+	return nil
+}
+
+// conflictColumns returns columns of conflict actions in order of insert columns,
+// actions for other columns follow them in sorted order
+func (b *insertStmt) conflictColumns() []string {
+	column := make([]string, 0, len(b.Conflict.actions))
+	inserted := make(map[string]bool, len(b.Column))
+	for _, col := range b.Column {
+		if _, ok := b.Conflict.actions[col]; ok && !inserted[col] {
+			column = append(column, col)
+		}
+		inserted[col] = true
+	}
+	n := len(column)
+	for col := range b.Conflict.actions {
+		if !inserted[col] {
+			column = append(column, col)
+		}
+	}
+	sort.Strings(column[n:])
+	return column
+}
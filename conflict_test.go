@@ -0,0 +1,234 @@
+package dbr
+
+import (
+	"testing"
+
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConflictStmt(t *testing.T) {
+	for _, test := range []struct {
+		stmt    func() InsertStmt
+		dialect Dialect
+		query   string
+		value   []interface{}
+	}{
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflict("table_pkey").Action("b", Proposed("b"))
+				return stmt
+			},
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "table" ("a","b") VALUES ($1,$2) ON CONFLICT ON CONSTRAINT "table_pkey" DO UPDATE SET "b"=EXCLUDED."b"`,
+			value:   []interface{}{1, "one"},
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflictColumns("a").Action("b", Proposed("b")).Where(Neq("table.b", Proposed("b")))
+				return stmt
+			},
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "table" ("a","b") VALUES ($1,$2) ON CONFLICT ("a") DO UPDATE SET "b"=EXCLUDED."b" WHERE ("table"."b" != EXCLUDED."b")`,
+			value:   []interface{}{1, "one"},
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflictColumns("a").TargetWhere(Eq("deleted", false)).DoNothing()
+				return stmt.Returning("id")
+			},
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "table" ("a","b") VALUES ($1,$2) ON CONFLICT ("a") WHERE ("deleted" = $3) DO NOTHING RETURNING "id"`,
+			value:   []interface{}{1, "one", false},
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflict("").DoNothing()
+				return stmt
+			},
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "table" ("a","b") VALUES ($1,$2) ON CONFLICT DO NOTHING`,
+			value:   []interface{}{1, "one"},
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("counters").Columns("id", "hits").Values(1, 1)
+				stmt.OnConflictColumns("id").Action("hits", Incr("counters.hits", 1))
+				return stmt
+			},
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "counters" ("id","hits") VALUES ($1,$2) ON CONFLICT ("id") DO UPDATE SET "hits"="counters"."hits" + $3`,
+			value:   []interface{}{1, 1, 1},
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("id", "a", "b").Values(1, 2, "one")
+				stmt.OnConflictColumns("id").Action("a", Default()).Action("b", nil)
+				return stmt
+			},
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "table" ("id","a","b") VALUES ($1,$2,$3) ON CONFLICT ("id") DO UPDATE SET "a"=DEFAULT,"b"=$4`,
+			value:   []interface{}{1, 2, "one", nil},
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("counters").Columns("id", "hits").Values(1, 1)
+				stmt.OnConflict("").Action("hits", Decr("hits", 1)).Action("reset_at", nil)
+				return stmt
+			},
+			dialect: dialect.MySQL,
+			query:   "INSERT INTO `counters` (`id`,`hits`) VALUES (1,1) ON DUPLICATE KEY UPDATE `hits`=`hits` - 1,`reset_at`=NULL",
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("id", "b").Values(1, "one")
+				stmt.OnConflictColumns("id").Action("z", 3).Action("b", Proposed("b")).Action("a", 2)
+				return stmt
+			},
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "table" ("id","b") VALUES ($1,$2) ON CONFLICT ("id") DO UPDATE SET "b"=EXCLUDED."b","a"=$3,"z"=$4`,
+			value:   []interface{}{1, "one", 2, 3},
+		},
+		{
+			stmt: func() InsertStmt {
+				return InsertInto("table").Columns("a").Values(1).OnConflictMap("", map[string]interface{}{})
+			},
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "table" ("a") VALUES ($1)`,
+			value:   []interface{}{1},
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflictColumns("a").DoNothing()
+				return stmt
+			},
+			dialect: dialect.MySQL,
+			query:   "INSERT IGNORE INTO `table` (`a`,`b`) VALUES (1,'one')",
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflictColumns("a").Action("b", Proposed("b"))
+				return stmt
+			},
+			dialect: dialect.MySQL,
+			query:   "INSERT INTO `table` (`a`,`b`) VALUES (1,'one') ON DUPLICATE KEY UPDATE `b`=VALUES(`b`)",
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflict("").Action("b", Expr("? + 1", Proposed("b")))
+				return stmt
+			},
+			dialect: dialect.MySQL8,
+			query:   "INSERT INTO `table` (`a`,`b`) VALUES (1,'one') AS new ON DUPLICATE KEY UPDATE `b`=new.`b` + 1",
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflictColumns("a").Action("b", Proposed("b")).Where("b IS NOT NULL")
+				return stmt
+			},
+			dialect: dialect.SQLite3,
+			query:   `INSERT INTO "table" ("a","b") VALUES (1,'one') ON CONFLICT ("a") DO UPDATE SET "b"=excluded."b" WHERE (b IS NOT NULL)`,
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("table").Columns("a", "b").Values(1, "one")
+				stmt.OnConflict("").DoNothing()
+				return stmt
+			},
+			dialect: dialect.SQLite3,
+			query:   `INSERT INTO "table" ("a","b") VALUES (1,'one') ON CONFLICT DO NOTHING`,
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("totals").Columns("id", "total").FromSelect(Select("id", "total").From("payments"))
+				stmt.OnConflictColumns("id").Action("total", Proposed("total"))
+				return stmt
+			},
+			dialect: dialect.SQLite3,
+			query:   `INSERT INTO "totals" ("id","total") SELECT * FROM (SELECT id, total FROM payments) WHERE true ON CONFLICT ("id") DO UPDATE SET "total"=excluded."total"`,
+		},
+		{
+			stmt: func() InsertStmt {
+				stmt := InsertInto("totals").Columns("id", "total").
+					FromSelect(Select("id", "total").From("payments").Where(Gt("total", 0)))
+				stmt.OnConflictColumns("id").DoNothing()
+				return stmt
+			},
+			dialect: dialect.SQLite3,
+			query:   `INSERT INTO "totals" ("id","total") SELECT id, total FROM payments WHERE ("total" > 0) ON CONFLICT ("id") DO NOTHING`,
+		},
+	} {
+		i := interpolator{
+			Buffer:     NewBuffer(),
+			Dialect:    test.dialect,
+			BindParams: test.value != nil,
+		}
+		err := i.interpolate(placeholder, []interface{}{test.stmt()})
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, i.String())
+		assert.Equal(t, test.value, i.Value())
+	}
+}
+
+func TestConflictStmtError(t *testing.T) {
+	stmt := InsertInto("table").Columns("a").Values(1)
+	stmt.OnConflictColumns("a")
+	err := stmt.Build(dialect.PostgreSQL, NewBuffer())
+	assert.Equal(t, ErrNoConflictAction, err)
+
+	stmt = InsertInto("table").Columns("a").Values(1)
+	stmt.OnConflict("").Action("a", 2)
+	err = stmt.Build(dialect.PostgreSQL, NewBuffer())
+	assert.Equal(t, ErrNoConflictTarget, err)
+
+	stmt = InsertInto("table").Columns("a").Values(1)
+	stmt.OnConflictColumns("a").TargetWhere("deleted = 0").DoNothing()
+	err = stmt.Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrNotSupported, err)
+
+	stmt = InsertInto("table").Columns("a").Values(1)
+	stmt.OnConflict("table_a_key").TargetWhere("deleted = 0").Action("a", 2)
+	err = stmt.Build(dialect.PostgreSQL, NewBuffer())
+	assert.Equal(t, ErrConflictTargetWhere, err)
+
+	stmt = InsertInto("table").Columns("a").Values(1)
+	stmt.OnConflict("").TargetWhere("deleted = 0").DoNothing()
+	err = stmt.Build(dialect.PostgreSQL, NewBuffer())
+	assert.Equal(t, ErrConflictTargetWhere, err)
+
+	stmt = InsertInto("table").Columns("a").Values(1)
+	stmt.OnConflictColumns("a").Where("a > 0").DoNothing()
+	err = stmt.Build(dialect.PostgreSQL, NewBuffer())
+	assert.Equal(t, ErrDoNothingWhere, err)
+	err = stmt.Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrDoNothingWhere, err)
+
+	stmt = InsertInto("table").Columns("a").Values(1)
+	stmt.OnConflictColumns("a").DoNothing()
+	err = stmt.Build(dialect.ClickHouse, NewBuffer())
+	assert.Error(t, err)
+
+	stmt = InsertInto("table").Columns("a").Values(1)
+	stmt.OnConflict("table_pkey").Action("a", 2)
+	err = stmt.Build(dialect.SQLite3, NewBuffer())
+	assert.Equal(t, ErrNotSupported, err)
+
+	// row alias can't be used with INSERT ... SELECT
+	stmt = InsertInto("totals").Columns("id", "total").FromSelect(Select("user_id", "amount").From("payments"))
+	stmt.OnConflict("").Action("total", Proposed("total"))
+	err = stmt.Build(dialect.MySQL8, NewBuffer())
+	assert.Equal(t, ErrNotSupported, err)
+
+	buf := NewBuffer()
+	err = stmt.Build(dialect.MySQL, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "INSERT INTO `totals` (`id`,`total`) SELECT user_id, amount FROM payments ON DUPLICATE KEY UPDATE `total`=?", buf.String())
+}
@@ -0,0 +1,43 @@
+package dbr
+
+import "context"
+
+// countStmt returns statement counting rows of b,
+// ORDER BY, LIMIT, OFFSET, locks, seek and WITH TOTALS are stripped as they don't change the total.
+// b is wrapped in a subquery when it is raw or has GROUP BY, DISTINCT or HAVING.
+func (b *selectStmt) countStmt() *selectStmt {
+	stmt := *b
+	stmt.Order = nil
+	stmt.Seek = nil
+	stmt.LimitCount = -1
+	stmt.OffsetCount = -1
+	stmt.IsForUpdate = false
+	stmt.IsSkipLocked = false
+	stmt.IsWithTotals = false
+
+	if stmt.raw.Query == "" && len(stmt.Group) == 0 && !stmt.IsDistinct && len(stmt.HavingCond) == 0 {
+		stmt.Column = []interface{}{"COUNT(*)"}
+		return &stmt
+	}
+
+	count := createSelectStmt([]interface{}{"COUNT(*)"})
+	// common table expressions should stay at the top level
+	count.CTE, stmt.CTE = stmt.CTE, nil
+	count.Table = as(&stmt, "dbr_count")
+	return count
+}
+
+// Count returns the number of rows the query selects regardless of LIMIT and OFFSET,
+// queries created by SelectBySql are counted as is
+func (b *selectBuilder) Count() (int64, error) {
+	return b.CountContext(b.ctx)
+}
+
+// CountContext returns the number of rows the query selects regardless of LIMIT and OFFSET
+func (b *selectBuilder) CountContext(ctx context.Context) (int64, error) {
+	var count int64
+	// BuildFunc hides SelectStmt, which is wrapped in parentheses as a subquery otherwise
+	stmt := BuildFunc(b.selectStmt.countStmt().Build)
+	_, err := query(ctx, b.runner, b.EventReceiver, stmt, b.Dialect, &count)
+	return count, err
+}
@@ -0,0 +1,83 @@
+package dbr
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountStmt(t *testing.T) {
+	for _, test := range []struct {
+		stmt  *selectStmt
+		query string
+	}{
+		{
+			stmt: Select("a", "b").From("t").Join("u", "t.id = u.t_id").Where(Eq("a", 1)).
+				OrderDesc("a").Limit(10).Offset(20).ForUpdate().(*selectStmt),
+			query: "SELECT COUNT(*) FROM t JOIN `u` ON t.id = u.t_id WHERE (`a` = 1)",
+		},
+		{
+			stmt:  Select("a").Distinct().From("t").OrderAsc("a").Limit(10).(*selectStmt),
+			query: "SELECT COUNT(*) FROM (SELECT DISTINCT a FROM t) AS `dbr_count`",
+		},
+		{
+			stmt: Select("a", "COUNT(*)").From("t").GroupBy("a").Having("COUNT(*) > ?", 1).
+				With("t", Select("*").From("s")).Limit(10).(*selectStmt),
+			query: "WITH `t` AS (SELECT * FROM s) SELECT COUNT(*) FROM (SELECT a, COUNT(*) FROM t GROUP BY a HAVING (COUNT(*) > 1)) AS `dbr_count`",
+		},
+		{
+			stmt:  SelectBySql("SELECT * FROM t WHERE a = ?", 1).(*selectStmt),
+			query: "SELECT COUNT(*) FROM (SELECT * FROM t WHERE a = 1) AS `dbr_count`",
+		},
+	} {
+		buf := NewBuffer()
+		err := test.stmt.countStmt().Build(dialect.MySQL, buf)
+		assert.NoError(t, err)
+		query, err := InterpolateForDialect(buf.String(), buf.Value(), dialect.MySQL)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, query)
+	}
+}
+
+func TestCount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.MySQL, EventReceiver: nullReceiver}
+	sess := conn.NewSession(nil)
+
+	mock.ExpectQuery(`^SELECT COUNT\(\*\) FROM people WHERE \(` + "`name`" + ` = 'a'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(42))
+	mock.ExpectQuery("^SELECT id FROM people").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	stmt := sess.Select("id").From("people").Where(Eq("name", "a")).OrderAsc("id").Limit(1)
+	count, err := stmt.Count()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 42, count)
+
+	// the builder itself is not changed
+	var id []int64
+	_, err = stmt.Load(&id)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountSQLite(t *testing.T) {
+	sess := sqlite3Session
+	reset(sess)
+	for _, name := range []string{"a", "a", "b"} {
+		_, err := sess.InsertInto("dbr_people").Columns("name").Values(name).Exec()
+		assert.NoError(t, err)
+	}
+
+	count, err := sess.Select("*").From("dbr_people").Where(Eq("name", "a")).Limit(1).Count()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+
+	count, err = sess.Select("name").Distinct().From("dbr_people").Count()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
@@ -45,6 +45,22 @@ type Connection struct {
 	DBConn
 	Dialect Dialect
 	EventReceiver
+
+	// RetryPolicy is used by Session.RunInTx, DefaultRetryPolicy is used if it is nil
+	RetryPolicy *RetryPolicy
+
+	// ParamMode is the default ParamMode of sessions
+	ParamMode ParamMode
+	// StmtCacheSize limits the number of prepared statements cached in BindParams mode,
+	// DefaultStmtCacheSize is used if it is zero
+	StmtCacheSize int
+	stmts         stmtCache
+}
+
+// Close closes cached prepared statements and the database
+func (conn *Connection) Close() error {
+	conn.stmts.close()
+	return conn.DBConn.Close()
 }
 
 // Session represents a business unit of execution for some connection
@@ -52,6 +68,12 @@ type Session struct {
 	*Connection
 	EventReceiver
 	ctx context.Context
+
+	// ParamMode overrides ParamMode of the connection
+	ParamMode ParamMode
+
+	cluster    *Cluster
+	usePrimary bool
 }
 
 // NewSession instantiates a Session for the Connection
@@ -64,15 +86,24 @@ func (conn *Connection) NewSessionContext(ctx context.Context, log EventReceiver
 	if log == nil {
 		log = conn.EventReceiver // Use parent instrumentation
 	}
-	return &Session{Connection: conn, EventReceiver: log, ctx: ctx}
+	return &Session{Connection: conn, EventReceiver: log, ctx: ctx, ParamMode: conn.ParamMode}
 }
 
 // NewSession forks current session
 func (sess *Session) NewSession(log EventReceiver) *Session {
 	if log == nil {
 		log = sess.EventReceiver
+	} else if sess.cluster != nil {
+		log = withNode(log, "primary")
+	}
+	return &Session{
+		Connection:    sess.Connection,
+		EventReceiver: log,
+		ctx:           sess.ctx,
+		ParamMode:     sess.ParamMode,
+		cluster:       sess.cluster,
+		usePrimary:    sess.usePrimary,
 	}
-	return &Session{Connection: sess.Connection, EventReceiver: log, ctx: sess.ctx}
 }
 
 // beginTx starts a transaction with context.
@@ -139,11 +170,22 @@ type loader interface {
 	LoadValuesContext(ctx context.Context, value interface{}) (int, error)
 }
 
+// returningLoader loads rows returned by `RETURNING` clause
+type returningLoader interface {
+	Load(value interface{}) (int, error)
+	LoadStruct(value interface{}) error
+	LoadStructs(value interface{}) (int, error)
+	LoadContext(ctx context.Context, value interface{}) (int, error)
+	LoadStructContext(ctx context.Context, value interface{}) error
+	LoadStructsContext(ctx context.Context, value interface{}) (int, error)
+}
+
 func exec(ctx context.Context, runner runner, log EventReceiver, builder Builder, d Dialect) (sql.Result, error) {
 	i := interpolator{
 		Buffer:       NewBuffer(),
 		Dialect:      d,
 		IgnoreBinary: true,
+		BindParams:   bindParams(runner),
 	}
 	err := i.interpolate(placeholder, []interface{}{builder})
 	query, value := i.String(), i.Value()
@@ -167,7 +209,7 @@ func exec(ctx context.Context, runner runner, log EventReceiver, builder Builder
 		defer traceImpl.SpanFinish(ctx)
 	}
 
-	result, err := runner.Exec(query, value...)
+	result, err := execStmt(ctx, runner, query, value)
 	if err != nil {
 		if hasTracingImpl {
 			traceImpl.SpanError(ctx, err)
@@ -181,45 +223,58 @@ func exec(ctx context.Context, runner runner, log EventReceiver, builder Builder
 }
 
 func queryRows(ctx context.Context, runner runner, log EventReceiver, builder Builder, d Dialect) (*sql.Rows, string, error) {
+	rows, query, finish, err := openRows(ctx, runner, log, builder, d)
+	if err != nil {
+		return nil, query, err
+	}
+	finish(nil)
+	return rows, query, nil
+}
+
+// openRows runs query and returns finish func, which reports timing and finishes tracing span.
+// finish must be called once rows are consumed.
+func openRows(ctx context.Context, runner runner, log EventReceiver, builder Builder, d Dialect) (*sql.Rows, string, func(error), error) {
 	i := interpolator{
 		Buffer:       NewBuffer(),
 		Dialect:      d,
 		IgnoreBinary: true,
+		BindParams:   bindParams(runner),
 	}
 	err := i.interpolate(placeholder, []interface{}{builder})
 	query, value := i.String(), i.Value()
 	if err != nil {
-		return nil, "", log.EventErrKv("dbr.select.interpolate", err, kvs{
+		return nil, "", nil, log.EventErrKv("dbr.select.interpolate", err, kvs{
 			"sql":  query,
 			"args": fmt.Sprint(value),
 		})
 	}
 
 	startTime := time.Now()
-	defer func() {
-		log.TimingKv("dbr.select", time.Since(startTime).Nanoseconds(), kvs{
-			"sql": query,
-		})
-	}()
-
 	traceImpl, hasTracingImpl := log.(TracingEventReceiver)
 	if hasTracingImpl {
 		ctx = traceImpl.SpanStart(ctx, "dbr.select", query)
-		defer traceImpl.SpanFinish(ctx)
 	}
-
-	rows, err := runner.QueryContext(ctx, query, value...)
-	if err != nil {
+	finish := func(err error) {
 		if hasTracingImpl {
-			traceImpl.SpanError(ctx, err)
+			if err != nil {
+				traceImpl.SpanError(ctx, err)
+			}
+			traceImpl.SpanFinish(ctx)
 		}
+		log.TimingKv("dbr.select", time.Since(startTime).Nanoseconds(), kvs{
+			"sql": query,
+		})
+	}
 
-		return nil, query, log.EventErrKv("dbr.select.load.query", err, kvs{
+	rows, err := queryStmt(ctx, runner, query, value)
+	if err != nil {
+		finish(err)
+		return nil, query, nil, log.EventErrKv("dbr.select.load.query", err, kvs{
 			"sql": query,
 		})
 	}
 
-	return rows, query, nil
+	return rows, query, finish, nil
 }
 
 func query(ctx context.Context, runner runner, log EventReceiver, builder Builder, d Dialect, dest interface{}) (int, error) {
@@ -5,7 +5,10 @@ package dbr
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/mailru/dbr/dialect"
 	"github.com/stretchr/testify/assert"
@@ -66,3 +69,69 @@ func checkTxExecContext(t *testing.T, conn *Connection) {
 	cancel()
 	assert.EqualError(t, tx.Commit(), "context canceled")
 }
+
+// blockingDriver is a stand-in driver which blocks every statement until its context is done
+type blockingDriver struct{}
+
+func (blockingDriver) Open(name string) (driver.Conn, error) {
+	return blockingConn{}, nil
+}
+
+type blockingConn struct{}
+
+func (blockingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("blocking driver: prepare is not supported")
+}
+
+func (blockingConn) Close() error {
+	return nil
+}
+
+func (blockingConn) Begin() (driver.Tx, error) {
+	return blockingTx{}, nil
+}
+
+func (blockingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+type blockingTx struct{}
+
+func (blockingTx) Commit() error {
+	return nil
+}
+
+func (blockingTx) Rollback() error {
+	return nil
+}
+
+func init() {
+	sql.Register("dbr_blocking", blockingDriver{})
+}
+
+func TestExecContextCancel(t *testing.T) {
+	db, err := sql.Open("dbr_blocking", "")
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: nullReceiver}
+	sess := conn.NewSession(nil)
+
+	for _, builder := range []Executer{
+		sess.InsertInto("dbr_people").Columns("name").Values("jonathan"),
+		sess.Update("dbr_people").Set("name", "jonathan1").Where(Eq("id", 1)),
+		sess.DeleteFrom("dbr_people").Where(Eq("id", 1)),
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		_, err := builder.ExecContext(ctx)
+		cancel()
+		assert.Equal(t, context.DeadlineExceeded, err)
+	}
+
+	tx, err := sess.Begin()
+	assert.NoError(t, err)
+	defer tx.RollbackUnlessCommitted()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = tx.Update("dbr_people").Set("name", "jonathan1").Where(Eq("id", 1)).ExecContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
@@ -256,17 +256,27 @@ func TestBasicCRUD(t *testing.T) {
 
 func TestOnConflict(t *testing.T) {
 	for _, sess := range testSession {
-		if sess.Dialect == dialect.SQLite3 || sess.Dialect == dialect.ClickHouse {
+		if sess.Dialect == dialect.ClickHouse {
 			continue
 		}
 		for i := 0; i < 2; i++ {
 			b := sess.InsertInto("dbr_keys").Columns("key_value", "val_value").Values("key", "value")
-			b.OnConflict("dbr_keys_pkey").Action("val_value", Expr("CONCAT(?, 2)", Proposed("val_value")))
+			if sess.Dialect == dialect.SQLite3 {
+				// SQLite has no constraint targets and CONCAT
+				b.OnConflictColumns("key_value").Action("val_value", Expr("? || 2", Proposed("val_value")))
+			} else {
+				b.OnConflict("dbr_keys_pkey").Action("val_value", Expr("CONCAT(?, 2)", Proposed("val_value")))
+			}
 			_, err := b.Exec()
 			assert.NoError(t, err)
 		}
+		b := sess.InsertInto("dbr_keys").Columns("key_value", "val_value").Values("key", "value")
+		b.OnConflictColumns("key_value").DoNothing()
+		_, err := b.Exec()
+		assert.NoError(t, err)
+
 		var value string
-		_, err := sess.SelectBySql("SELECT val_value FROM dbr_keys WHERE key_value=?", "key").Load(&value)
+		_, err = sess.SelectBySql("SELECT val_value FROM dbr_keys WHERE key_value=?", "key").Load(&value)
 		assert.NoError(t, err)
 		assert.Equal(t, "value2", value)
 	}
@@ -4,13 +4,19 @@ package dbr
 type DeleteStmt interface {
 	Builder
 	Where(query interface{}, value ...interface{}) DeleteStmt
+	With(name string, builder Builder) DeleteStmt
+	WithRecursive(name string, builder Builder) DeleteStmt
+	Returning(column ...string) DeleteStmt
 }
 
 type deleteStmt struct {
 	raw
 
+	CTE       []*cte
 	Table     string
 	WhereCond []Builder
+
+	ReturnColumn []string
 }
 
 // Build builds `DELETE ...` in dialect
@@ -23,17 +29,22 @@ func (b *deleteStmt) Build(d Dialect, buf Buffer) error {
 		return ErrTableNotSpecified
 	}
 
+	err := buildWith(d, buf, b.CTE)
+	if err != nil {
+		return err
+	}
+
 	buf.WriteString("DELETE FROM ")
 	buf.WriteString(d.QuoteIdent(b.Table))
 
 	if len(b.WhereCond) > 0 {
 		buf.WriteString(" WHERE ")
-		err := And(b.WhereCond...).Build(d, buf)
+		err = And(b.WhereCond...).Build(d, buf)
 		if err != nil {
 			return err
 		}
 	}
-	return nil
+	return buildReturning(d, buf, b.ReturnColumn)
 }
 
 // DeleteFrom creates a DeleteStmt
@@ -71,3 +82,21 @@ func (b *deleteStmt) Where(query interface{}, value ...interface{}) DeleteStmt {
 	}
 	return b
 }
+
+// With adds a common table expression `WITH name AS (...)`
+func (b *deleteStmt) With(name string, builder Builder) DeleteStmt {
+	b.CTE = append(b.CTE, &cte{name: name, builder: builder})
+	return b
+}
+
+// WithRecursive adds a recursive common table expression `WITH RECURSIVE name AS (...)`
+func (b *deleteStmt) WithRecursive(name string, builder Builder) DeleteStmt {
+	b.CTE = append(b.CTE, &cte{name: name, builder: builder, recursive: true})
+	return b
+}
+
+// Returning adds `RETURNING` clause, columns are loaded via Load* methods of DeleteBuilder
+func (b *deleteStmt) Returning(column ...string) DeleteStmt {
+	b.ReturnColumn = append(b.ReturnColumn, column...)
+	return b
+}
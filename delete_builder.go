@@ -11,9 +11,13 @@ type DeleteBuilder interface {
 	Builder
 	EventReceiver
 	Executer
+	returningLoader
 
 	Where(query interface{}, value ...interface{}) DeleteBuilder
 	Limit(n uint64) DeleteBuilder
+	With(name string, builder Builder) DeleteBuilder
+	WithRecursive(name string, builder Builder) DeleteBuilder
+	Returning(column ...string) DeleteBuilder
 }
 
 type deleteBuilder struct {
@@ -90,6 +94,18 @@ func (b *deleteBuilder) Where(query interface{}, value ...interface{}) DeleteBui
 	return b
 }
 
+// With adds a common table expression `WITH name AS (...)`
+func (b *deleteBuilder) With(name string, builder Builder) DeleteBuilder {
+	b.deleteStmt.With(name, builder)
+	return b
+}
+
+// WithRecursive adds a recursive common table expression `WITH RECURSIVE name AS (...)`
+func (b *deleteBuilder) WithRecursive(name string, builder Builder) DeleteBuilder {
+	b.deleteStmt.WithRecursive(name, builder)
+	return b
+}
+
 // Limit adds LIMIT
 func (b *deleteBuilder) Limit(n uint64) DeleteBuilder {
 	b.LimitCount = int64(n)
@@ -108,3 +124,46 @@ func (b *deleteBuilder) Build(d Dialect, buf Buffer) error {
 	}
 	return nil
 }
+
+// Returning adds `RETURNING` clause
+func (b *deleteBuilder) Returning(column ...string) DeleteBuilder {
+	b.deleteStmt.Returning(column...)
+	return b
+}
+
+// Load loads any value returned by `RETURNING` clause with background context
+func (b *deleteBuilder) Load(value interface{}) (int, error) {
+	return b.LoadContext(b.ctx, value)
+}
+
+// LoadContext loads any value returned by `RETURNING` clause
+func (b *deleteBuilder) LoadContext(ctx context.Context, value interface{}) (int, error) {
+	return query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+}
+
+// LoadStruct loads struct returned by `RETURNING` clause with background context, returns ErrNotFound if there is no result
+func (b *deleteBuilder) LoadStruct(value interface{}) error {
+	return b.LoadStructContext(b.ctx, value)
+}
+
+// LoadStructContext loads struct returned by `RETURNING` clause, returns ErrNotFound if there is no result
+func (b *deleteBuilder) LoadStructContext(ctx context.Context, value interface{}) error {
+	count, err := query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// LoadStructs loads structures returned by `RETURNING` clause with background context
+func (b *deleteBuilder) LoadStructs(value interface{}) (int, error) {
+	return b.LoadStructsContext(b.ctx, value)
+}
+
+// LoadStructsContext loads structures returned by `RETURNING` clause
+func (b *deleteBuilder) LoadStructsContext(ctx context.Context, value interface{}) (int, error) {
+	return query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+}
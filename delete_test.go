@@ -16,6 +16,29 @@ func TestDeleteStmt(t *testing.T) {
 	assert.Equal(t, []interface{}{1}, buf.Value())
 }
 
+func TestDeleteStmtWith(t *testing.T) {
+	buf := NewBuffer()
+	builder := DeleteFrom("table").
+		With("stale", Select("id").From("table").Where(Lt("updated_at", 10))).
+		Where("id IN ?", Select("id").From("stale"))
+	err := builder.Build(dialect.PostgreSQL, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, `WITH "stale" AS (SELECT id FROM table WHERE ("updated_at" < ?)) DELETE FROM "table" WHERE (id IN ?)`, buf.String())
+	assert.Equal(t, 2, len(buf.Value()))
+}
+
+func TestDeleteStmtReturning(t *testing.T) {
+	buf := NewBuffer()
+	builder := DeleteFrom("table").Where(Eq("a", 1)).Returning("id")
+	err := builder.Build(dialect.PostgreSQL, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, `DELETE FROM "table" WHERE ("a" = ?) RETURNING "id"`, buf.String())
+	assert.Equal(t, []interface{}{1}, buf.Value())
+
+	err = builder.Build(dialect.ClickHouse, NewBuffer())
+	assert.Equal(t, ErrReturningNotSupported, err)
+}
+
 func BenchmarkDeleteSQL(b *testing.B) {
 	buf := NewBuffer()
 	for i := 0; i < b.N; i++ {
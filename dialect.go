@@ -1,6 +1,10 @@
 package dbr
 
-import "time"
+import (
+	"time"
+
+	"github.com/mailru/dbr/dialect"
+)
 
 // Dialect abstracts database differences
 type Dialect interface {
@@ -11,8 +15,49 @@ type Dialect interface {
 	EncodeTime(t time.Time) string
 	EncodeBytes(b []byte) string
 	Placeholder(n int) string
-	OnConflict(constraint string) string
+	// MaxParams returns the maximum number of parameters in a statement, 0 means no limit
+	MaxParams() int
+	// Syntax returns rules of string literals, quoted identifiers and comments for parsing raw queries
+	Syntax() dialect.Syntax
+	// OnConflict and OnConflictDoNothing return clause updating or skipping rows on conflict with target,
+	// target is a list of columns or ConflictConstraint, they return empty string if upsert is not supported
+	OnConflict(target string) string
+	OnConflictDoNothing(target string) string
+	ConflictConstraint(name string) string
+	// InsertIgnore returns `INSERT` keyword skipping conflicting rows, it is used instead of OnConflictDoNothing
+	InsertIgnore() string
+	// Replace returns keyword of `REPLACE INTO`, it returns empty string if it is not supported
+	Replace() string
+	// AmbiguousUpsertSelect reports whether SELECT of `INSERT ... SELECT ... ON CONFLICT` needs WHERE
+	// to not parse `ON` as join constraint
+	AmbiguousUpsertSelect() bool
+	// SupportsConflictWhere reports whether conflict target and update can have WHERE
+	SupportsConflictWhere() bool
 	Proposed(column string) string
+	// RowAlias returns alias of inserted row used by Proposed, e.g. `AS new`, it can't be used with INSERT ... SELECT
+	RowAlias() string
 	Limit(offset, limit int64) string
 	Prewhere() string
+	Returning() string
+	Savepoint(name string) string
+	RollbackToSavepoint(name string) string
+	ReleaseSavepoint(name string) string
+	ILike() string
+	// SupportsRowValues reports whether tuples like `(a, b) > (?, ?)` can be compared
+	SupportsRowValues() bool
+	// Intersect and Except return keyword of the set operation, keeping duplicates if all is true,
+	// they return empty string if the operation is not supported
+	Intersect(all bool) string
+	Except(all bool) string
+	// SupportsCompoundParens reports whether members of UNION, INTERSECT and EXCEPT can be in parentheses
+	SupportsCompoundParens() bool
+	// Rollup, Cube and GroupingSets return grouping clause for the rendered columns or sets,
+	// they return empty string if grouping is not supported
+	Rollup(columns string) string
+	Cube(columns string) string
+	GroupingSets(sets string) string
+	WithTotals() string
+	// SupportsMixedGrouping reports whether Rollup, Cube and GroupingSets can be combined
+	// with other GROUP BY items, it is false if they modify the whole GROUP BY like `WITH ROLLUP`
+	SupportsMixedGrouping() bool
 }
@@ -68,14 +68,46 @@ func (d clickhouse) Placeholder(_ int) string {
 	return "?"
 }
 
+func (d clickhouse) MaxParams() int {
+	return 0
+}
+
 func (d clickhouse) OnConflict(_ string) string {
 	return ""
 }
 
+func (d clickhouse) OnConflictDoNothing(_ string) string {
+	return ""
+}
+
+func (d clickhouse) ConflictConstraint(_ string) string {
+	return ""
+}
+
+func (d clickhouse) InsertIgnore() string {
+	return ""
+}
+
+func (d clickhouse) Replace() string {
+	return ""
+}
+
+func (d clickhouse) AmbiguousUpsertSelect() bool {
+	return false
+}
+
+func (d clickhouse) SupportsConflictWhere() bool {
+	return false
+}
+
 func (d clickhouse) Proposed(_ string) string {
 	return ""
 }
 
+func (d clickhouse) RowAlias() string {
+	return ""
+}
+
 func (d clickhouse) Limit(offset, limit int64) string {
 	if offset < 0 {
 		return fmt.Sprintf("LIMIT %d", limit)
@@ -90,3 +122,69 @@ func (d clickhouse) String() string {
 func (d clickhouse) Prewhere() string {
 	return "PREWHERE"
 }
+
+func (d clickhouse) Returning() string {
+	return ""
+}
+
+func (d clickhouse) Savepoint(_ string) string {
+	return ""
+}
+
+func (d clickhouse) RollbackToSavepoint(_ string) string {
+	return ""
+}
+
+func (d clickhouse) ReleaseSavepoint(_ string) string {
+	return ""
+}
+
+func (d clickhouse) Syntax() Syntax {
+	return Syntax{BackslashEscapes: true}
+}
+
+func (d clickhouse) ILike() string {
+	return "ILIKE"
+}
+
+func (d clickhouse) SupportsRowValues() bool {
+	return true
+}
+
+func (d clickhouse) Intersect(all bool) string {
+	if all {
+		return ""
+	}
+	return "INTERSECT"
+}
+
+func (d clickhouse) Except(all bool) string {
+	if all {
+		return ""
+	}
+	return "EXCEPT"
+}
+
+func (d clickhouse) SupportsCompoundParens() bool {
+	return true
+}
+
+func (d clickhouse) Rollup(columns string) string {
+	return columns + " WITH ROLLUP"
+}
+
+func (d clickhouse) Cube(columns string) string {
+	return columns + " WITH CUBE"
+}
+
+func (d clickhouse) GroupingSets(sets string) string {
+	return "GROUPING SETS (" + sets + ")"
+}
+
+func (d clickhouse) WithTotals() string {
+	return "WITH TOTALS"
+}
+
+func (d clickhouse) SupportsMixedGrouping() bool {
+	return false
+}
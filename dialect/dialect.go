@@ -7,12 +7,33 @@ var (
 	ClickHouse = clickhouse{}
 	// MySQL dialect
 	MySQL = mysql{}
+	// MySQL8 dialect for MySQL 8.0.19+, it uses row alias in upsert
+	MySQL8 = mysql8{}
 	// PostgreSQL dialect
 	PostgreSQL = postgreSQL{}
 	// SQLite3 dialect
 	SQLite3 = sqlite3{}
 )
 
+// Syntax describes string literals, quoted identifiers and comments of the dialect,
+// placeholders are not replaced inside them
+type Syntax struct {
+	// BackslashEscapes means backslash escapes quotes in string literals
+	BackslashEscapes bool
+	// EscapeStrings are E'...' strings with backslash escapes
+	EscapeStrings bool
+	// DollarQuotes are $tag$...$tag$ strings
+	DollarQuotes bool
+	// BracketIdentifiers are [...] quoted identifiers
+	BracketIdentifiers bool
+	// HashComments are # comments
+	HashComments bool
+	// DashCommentSpace means -- comments require a whitespace after dashes
+	DashCommentSpace bool
+	// NestedComments means /* ... */ comments can be nested
+	NestedComments bool
+}
+
 const (
 	timeFormat = "2006-01-02 15:04:05.000000"
 )
@@ -65,14 +65,47 @@ func (d mysql) Placeholder(_ int) string {
 	return "?"
 }
 
+func (d mysql) MaxParams() int {
+	return 65535
+}
+
 func (d mysql) OnConflict(_ string) string {
+	// MySQL checks all unique indexes, so target is ignored
 	return "ON DUPLICATE KEY UPDATE"
 }
 
+func (d mysql) OnConflictDoNothing(_ string) string {
+	return ""
+}
+
+func (d mysql) ConflictConstraint(name string) string {
+	return d.QuoteIdent(name)
+}
+
+func (d mysql) InsertIgnore() string {
+	return "INSERT IGNORE"
+}
+
+func (d mysql) Replace() string {
+	return "REPLACE"
+}
+
+func (d mysql) AmbiguousUpsertSelect() bool {
+	return false
+}
+
+func (d mysql) SupportsConflictWhere() bool {
+	return false
+}
+
 func (d mysql) Proposed(column string) string {
 	return fmt.Sprintf("VALUES(%s)", d.QuoteIdent(column))
 }
 
+func (d mysql) RowAlias() string {
+	return ""
+}
+
 func (d mysql) Limit(offset, limit int64) string {
 	if offset < 0 {
 		return fmt.Sprintf("LIMIT %d", limit)
@@ -83,3 +116,63 @@ func (d mysql) Limit(offset, limit int64) string {
 func (d mysql) Prewhere() string {
 	return ""
 }
+
+func (d mysql) Returning() string {
+	return ""
+}
+
+func (d mysql) Savepoint(name string) string {
+	return "SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d mysql) RollbackToSavepoint(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d mysql) ReleaseSavepoint(name string) string {
+	return "RELEASE SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d mysql) Syntax() Syntax {
+	return Syntax{BackslashEscapes: true, HashComments: true, DashCommentSpace: true}
+}
+
+func (d mysql) ILike() string {
+	return ""
+}
+
+func (d mysql) SupportsRowValues() bool {
+	return true
+}
+
+func (d mysql) Intersect(all bool) string {
+	return ""
+}
+
+func (d mysql) Except(all bool) string {
+	return ""
+}
+
+func (d mysql) SupportsCompoundParens() bool {
+	return true
+}
+
+func (d mysql) Rollup(columns string) string {
+	return columns + " WITH ROLLUP"
+}
+
+func (d mysql) Cube(_ string) string {
+	return ""
+}
+
+func (d mysql) GroupingSets(_ string) string {
+	return ""
+}
+
+func (d mysql) WithTotals() string {
+	return ""
+}
+
+func (d mysql) SupportsMixedGrouping() bool {
+	return false
+}
@@ -0,0 +1,17 @@
+package dialect
+
+import "fmt"
+
+// mysql8 uses row alias for proposed values, VALUES() is deprecated since MySQL 8.0.20
+type mysql8 struct {
+	mysql
+}
+
+func (d mysql8) RowAlias() string {
+	// https://dev.mysql.com/doc/refman/8.0/en/insert-on-duplicate.html
+	return "new"
+}
+
+func (d mysql8) Proposed(column string) string {
+	return fmt.Sprintf("new.%s", d.QuoteIdent(column))
+}
@@ -36,14 +36,54 @@ func (d postgreSQL) Placeholder(n int) string {
 	return fmt.Sprintf("$%d", n+1)
 }
 
-func (d postgreSQL) OnConflict(constraint string) string {
-	return fmt.Sprintf("ON CONFLICT ON CONSTRAINT %s DO UPDATE SET", d.QuoteIdent(constraint))
+func (d postgreSQL) MaxParams() int {
+	return 65535
+}
+
+func (d postgreSQL) OnConflict(target string) string {
+	// https://www.postgresql.org/docs/current/sql-insert.html#SQL-ON-CONFLICT
+	// DO UPDATE requires conflict target
+	if target == "" {
+		return ""
+	}
+	return fmt.Sprintf("ON CONFLICT %s DO UPDATE SET", target)
+}
+
+func (d postgreSQL) OnConflictDoNothing(target string) string {
+	if target == "" {
+		return "ON CONFLICT DO NOTHING"
+	}
+	return fmt.Sprintf("ON CONFLICT %s DO NOTHING", target)
+}
+
+func (d postgreSQL) ConflictConstraint(name string) string {
+	return "ON CONSTRAINT " + d.QuoteIdent(name)
+}
+
+func (d postgreSQL) InsertIgnore() string {
+	return ""
+}
+
+func (d postgreSQL) Replace() string {
+	return ""
+}
+
+func (d postgreSQL) AmbiguousUpsertSelect() bool {
+	return false
+}
+
+func (d postgreSQL) SupportsConflictWhere() bool {
+	return true
 }
 
 func (d postgreSQL) Proposed(column string) string {
 	return fmt.Sprintf("EXCLUDED.%s", d.QuoteIdent(column))
 }
 
+func (d postgreSQL) RowAlias() string {
+	return ""
+}
+
 func (d postgreSQL) Limit(offset, limit int64) string {
 	if offset < 0 {
 		return fmt.Sprintf("LIMIT %d", limit)
@@ -54,3 +94,69 @@ func (d postgreSQL) Limit(offset, limit int64) string {
 func (d postgreSQL) Prewhere() string {
 	return ""
 }
+
+func (d postgreSQL) Returning() string {
+	return "RETURNING"
+}
+
+func (d postgreSQL) Savepoint(name string) string {
+	return "SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d postgreSQL) RollbackToSavepoint(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d postgreSQL) ReleaseSavepoint(name string) string {
+	return "RELEASE SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d postgreSQL) Syntax() Syntax {
+	return Syntax{EscapeStrings: true, DollarQuotes: true, NestedComments: true}
+}
+
+func (d postgreSQL) ILike() string {
+	return "ILIKE"
+}
+
+func (d postgreSQL) SupportsRowValues() bool {
+	return true
+}
+
+func (d postgreSQL) Intersect(all bool) string {
+	if all {
+		return "INTERSECT ALL"
+	}
+	return "INTERSECT"
+}
+
+func (d postgreSQL) Except(all bool) string {
+	if all {
+		return "EXCEPT ALL"
+	}
+	return "EXCEPT"
+}
+
+func (d postgreSQL) SupportsCompoundParens() bool {
+	return true
+}
+
+func (d postgreSQL) Rollup(columns string) string {
+	return "ROLLUP (" + columns + ")"
+}
+
+func (d postgreSQL) Cube(columns string) string {
+	return "CUBE (" + columns + ")"
+}
+
+func (d postgreSQL) GroupingSets(sets string) string {
+	return "GROUPING SETS (" + sets + ")"
+}
+
+func (d postgreSQL) WithTotals() string {
+	return ""
+}
+
+func (d postgreSQL) SupportsMixedGrouping() bool {
+	return true
+}
@@ -39,11 +39,53 @@ func (d sqlite3) Placeholder(_ int) string {
 	return "?"
 }
 
-func (d sqlite3) OnConflict(_ string) string {
+func (d sqlite3) MaxParams() int {
+	// SQLITE_MAX_VARIABLE_NUMBER is 999 before 3.32.0
+	return 999
+}
+
+func (d sqlite3) OnConflict(target string) string {
+	// https://www.sqlite.org/lang_upsert.html
+	// target can be omitted for the last clause only since 3.35
+	if target == "" {
+		return ""
+	}
+	return fmt.Sprintf("ON CONFLICT %s DO UPDATE SET", target)
+}
+
+func (d sqlite3) OnConflictDoNothing(target string) string {
+	if target == "" {
+		return "ON CONFLICT DO NOTHING"
+	}
+	return fmt.Sprintf("ON CONFLICT %s DO NOTHING", target)
+}
+
+func (d sqlite3) ConflictConstraint(_ string) string {
+	// conflict target is a list of columns of unique index
+	return ""
+}
+
+func (d sqlite3) InsertIgnore() string {
 	return ""
 }
 
-func (d sqlite3) Proposed(_ string) string {
+func (d sqlite3) Replace() string {
+	return "REPLACE"
+}
+
+func (d sqlite3) AmbiguousUpsertSelect() bool {
+	return true
+}
+
+func (d sqlite3) SupportsConflictWhere() bool {
+	return true
+}
+
+func (d sqlite3) Proposed(column string) string {
+	return fmt.Sprintf("excluded.%s", d.QuoteIdent(column))
+}
+
+func (d sqlite3) RowAlias() string {
 	return ""
 }
 
@@ -57,3 +99,70 @@ func (d sqlite3) Limit(offset, limit int64) string {
 func (d sqlite3) Prewhere() string {
 	return ""
 }
+
+func (d sqlite3) Returning() string {
+	// https://www.sqlite.org/lang_returning.html
+	return "RETURNING"
+}
+
+func (d sqlite3) Savepoint(name string) string {
+	return "SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d sqlite3) RollbackToSavepoint(name string) string {
+	return "ROLLBACK TO SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d sqlite3) ReleaseSavepoint(name string) string {
+	return "RELEASE SAVEPOINT " + d.QuoteIdent(name)
+}
+
+func (d sqlite3) Syntax() Syntax {
+	return Syntax{BracketIdentifiers: true}
+}
+
+func (d sqlite3) ILike() string {
+	return ""
+}
+
+func (d sqlite3) SupportsRowValues() bool {
+	return true
+}
+
+func (d sqlite3) Intersect(all bool) string {
+	if all {
+		return ""
+	}
+	return "INTERSECT"
+}
+
+func (d sqlite3) Except(all bool) string {
+	if all {
+		return ""
+	}
+	return "EXCEPT"
+}
+
+func (d sqlite3) SupportsCompoundParens() bool {
+	return false
+}
+
+func (d sqlite3) Rollup(_ string) string {
+	return ""
+}
+
+func (d sqlite3) Cube(_ string) string {
+	return ""
+}
+
+func (d sqlite3) GroupingSets(_ string) string {
+	return ""
+}
+
+func (d sqlite3) WithTotals() string {
+	return ""
+}
+
+func (d sqlite3) SupportsMixedGrouping() bool {
+	return false
+}
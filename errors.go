@@ -4,14 +4,25 @@ import "errors"
 
 // package errors
 var (
-	ErrNotFound             = errors.New("dbr: not found")
-	ErrNotSupported         = errors.New("dbr: not supported")
-	ErrTableNotSpecified    = errors.New("dbr: table not specified")
-	ErrColumnNotSpecified   = errors.New("dbr: column not specified")
-	ErrInvalidPointer       = errors.New("dbr: attempt to load into an invalid pointer")
-	ErrPlaceholderCount     = errors.New("dbr: wrong placeholder count")
-	ErrInvalidSliceLength   = errors.New("dbr: length of slice is 0. length must be >= 1")
-	ErrCantConvertToTime    = errors.New("dbr: can't convert to time.Time")
-	ErrInvalidTimestring    = errors.New("dbr: invalid time string")
-	ErrPrewhereNotSupported = errors.New("dbr: PREWHERE statement is not supported")
+	ErrNotFound               = errors.New("dbr: not found")
+	ErrNotSupported           = errors.New("dbr: not supported")
+	ErrTableNotSpecified      = errors.New("dbr: table not specified")
+	ErrColumnNotSpecified     = errors.New("dbr: column not specified")
+	ErrInvalidPointer         = errors.New("dbr: attempt to load into an invalid pointer")
+	ErrPlaceholderCount       = errors.New("dbr: wrong placeholder count")
+	ErrInvalidSliceLength     = errors.New("dbr: length of slice is 0. length must be >= 1")
+	ErrCantConvertToTime      = errors.New("dbr: can't convert to time.Time")
+	ErrInvalidTimestring      = errors.New("dbr: invalid time string")
+	ErrPrewhereNotSupported   = errors.New("dbr: PREWHERE statement is not supported")
+	ErrReturningNotSupported  = errors.New("dbr: RETURNING statement is not supported")
+	ErrNamedParameterNotFound = errors.New("dbr: named parameter not found")
+	ErrInvalidTupleLength     = errors.New("dbr: length of tuple must be equal to the number of columns")
+	ErrInvalidCursor          = errors.New("dbr: invalid cursor")
+	ErrInvalidSeekOrder       = errors.New("dbr: seek requires ORDER BY columns")
+	ErrValuesWithSelect       = errors.New("dbr: values can't be inserted along with select")
+	ErrNoConflictAction       = errors.New("dbr: conflict action not specified")
+	ErrNoConflictTarget       = errors.New("dbr: conflict target not specified")
+	ErrInvalidGroupingColumn  = errors.New("dbr: grouping column must be a string or a Builder")
+	ErrConflictTargetWhere    = errors.New("dbr: conflict target WHERE requires target columns")
+	ErrDoNothingWhere         = errors.New("dbr: conflict WHERE can't be used with DO NOTHING")
 )
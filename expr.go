@@ -5,13 +5,55 @@ type raw struct {
 	Value []interface{}
 }
 
-// Expr should be used when sql syntax is not supported
+// Expr should be used when sql syntax is not supported.
+// Named parameters like `:name` or `@name` are bound when the only value is a map or a struct
+// and query has no `?` placeholders.
 func Expr(query string, value ...interface{}) Builder {
 	return &raw{Query: query, Value: value}
 }
 
-func (raw *raw) Build(_ Dialect, buf Buffer) error {
+func (raw *raw) Build(d Dialect, buf Buffer) error {
+	if len(raw.Value) == 1 && isNamedValue(raw.Value[0]) && !newLexer(d).hasPlaceholder(raw.Query) {
+		query, value, err := bindNamed(d, raw.Query, raw.Value[0])
+		if err != nil {
+			return err
+		}
+		buf.WriteString(query)
+		buf.WriteValue(value...)
+		return nil
+	}
+
 	buf.WriteString(raw.Query)
 	buf.WriteValue(raw.Value...)
 	return nil
 }
+
+// Incr is `column + n`, it can be used as value in Set and ConflictStmt.Action.
+// PostgreSQL ON CONFLICT requires the column qualified with table name like `counters.hits`,
+// a bare column is ambiguous with the EXCLUDED row there.
+func Incr(column string, n interface{}) Builder {
+	return arith(column, "+", n)
+}
+
+// Decr is `column - n`, it can be used as value in Set and ConflictStmt.Action.
+// As for Incr, the column must be qualified with table name in PostgreSQL ON CONFLICT.
+func Decr(column string, n interface{}) Builder {
+	return arith(column, "-", n)
+}
+
+func arith(column, op string, n interface{}) Builder {
+	return BuildFunc(func(d Dialect, buf Buffer) error {
+		buf.WriteString(d.QuoteIdent(column))
+		buf.WriteString(" ")
+		buf.WriteString(op)
+		buf.WriteString(" ")
+		buf.WriteString(placeholder)
+		buf.WriteValue(n)
+		return nil
+	})
+}
+
+// Default is `DEFAULT` value of column, SQLite does not support it in UPDATE
+func Default() Builder {
+	return Expr("DEFAULT")
+}
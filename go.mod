@@ -7,7 +7,7 @@ require (
 	github.com/go-sql-driver/mysql v1.4.1
 	github.com/lib/pq v1.2.0
 	github.com/mailru/go-clickhouse v1.1.0
-	github.com/mattn/go-sqlite3 v1.11.0
+	github.com/mattn/go-sqlite3 v1.14.16
 	github.com/stretchr/testify v1.4.0
 	google.golang.org/appengine v1.6.2 // indirect
 )
@@ -0,0 +1,109 @@
+package dbr
+
+type groupingKind int
+
+const (
+	rollup groupingKind = iota
+	cube
+	groupingSets
+)
+
+type grouping struct {
+	kind groupingKind
+	set  [][]Builder
+	err  error
+}
+
+// groupItems converts columns of grouping, which can be strings or Builders
+func groupItems(col []interface{}) ([]Builder, error) {
+	item := make([]Builder, 0, len(col))
+	for _, c := range col {
+		switch c := c.(type) {
+		case string:
+			item = append(item, Expr(c))
+		case Builder:
+			item = append(item, c)
+		default:
+			return nil, ErrInvalidGroupingColumn
+		}
+	}
+	return item, nil
+}
+
+func newGrouping(kind groupingKind, set ...[]interface{}) *grouping {
+	g := &grouping{kind: kind}
+	for _, s := range set {
+		item, err := groupItems(s)
+		if err != nil {
+			g.err = err
+		}
+		g.set = append(g.set, item)
+	}
+	return g
+}
+
+// checkGroup returns ErrNotSupported if grouping is a modifier of whole GROUP BY in dialect,
+// like `WITH ROLLUP`, and it is combined with other items
+func checkGroup(d Dialect, group []Builder) error {
+	if len(group) < 2 || d.SupportsMixedGrouping() {
+		return nil
+	}
+	for _, g := range group {
+		if _, ok := g.(*grouping); ok {
+			return ErrNotSupported
+		}
+	}
+	return nil
+}
+
+func buildGroupItems(d Dialect, buf Buffer, item []Builder) error {
+	for i, b := range item {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		err := b.Build(d, buf)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Build builds grouping in dialect, ErrNotSupported is returned if dialect does not support it
+func (g *grouping) Build(d Dialect, buf Buffer) error {
+	if g.err != nil {
+		return g.err
+	}
+	items := NewBuffer()
+	for i, set := range g.set {
+		if g.kind == groupingSets {
+			if i > 0 {
+				items.WriteString(", ")
+			}
+			items.WriteString("(")
+		}
+		err := buildGroupItems(d, items, set)
+		if err != nil {
+			return err
+		}
+		if g.kind == groupingSets {
+			items.WriteString(")")
+		}
+	}
+
+	var s string
+	switch g.kind {
+	case rollup:
+		s = d.Rollup(items.String())
+	case cube:
+		s = d.Cube(items.String())
+	case groupingSets:
+		s = d.GroupingSets(items.String())
+	}
+	if s == "" {
+		return ErrNotSupported
+	}
+	buf.WriteString(s)
+	buf.WriteValue(items.Value()...)
+	return nil
+}
@@ -0,0 +1,82 @@
+package dbr
+
+import (
+	"testing"
+
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrouping(t *testing.T) {
+	for _, test := range []struct {
+		stmt    SelectStmt
+		dialect Dialect
+		query   string
+		value   []interface{}
+	}{
+		{
+			stmt:    Select("*").From("t").GroupByExpr(Expr("DATE_FORMAT(created_at, ?)", "%Y-%m")),
+			dialect: dialect.MySQL,
+			query:   "SELECT * FROM t GROUP BY DATE_FORMAT(created_at, ?)",
+			value:   []interface{}{"%Y-%m"},
+		},
+		{
+			stmt:    Select("*").From("t").GroupByRollup("a", Expr("b + ?", 1)),
+			dialect: dialect.MySQL,
+			query:   "SELECT * FROM t GROUP BY a, b + ? WITH ROLLUP",
+			value:   []interface{}{1},
+		},
+		{
+			stmt:    Select("*").From("t").GroupBy("a").GroupByRollup("b", "c"),
+			dialect: dialect.PostgreSQL,
+			query:   "SELECT * FROM t GROUP BY a, ROLLUP (b, c)",
+		},
+		{
+			stmt:    Select("*").From("t").GroupByCube("a", "b"),
+			dialect: dialect.PostgreSQL,
+			query:   "SELECT * FROM t GROUP BY CUBE (a, b)",
+		},
+		{
+			stmt:    Select("*").From("t").GroupingSets([]interface{}{"a", "b"}, []interface{}{"a"}, nil),
+			dialect: dialect.PostgreSQL,
+			query:   "SELECT * FROM t GROUP BY GROUPING SETS ((a, b), (a), ())",
+		},
+		{
+			stmt:    Select("*").From("t").GroupByCube("a", "b").WithTotals().Having("count() > ?", 1),
+			dialect: dialect.ClickHouse,
+			query:   "SELECT * FROM t GROUP BY a, b WITH CUBE WITH TOTALS HAVING (count() > ?)",
+			value:   []interface{}{1},
+		},
+	} {
+		buf := NewBuffer()
+		err := test.stmt.Build(test.dialect, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, buf.String())
+		assert.Equal(t, test.value, buf.Value())
+	}
+}
+
+func TestGroupingNotSupported(t *testing.T) {
+	for _, test := range []struct {
+		stmt    SelectStmt
+		dialect Dialect
+	}{
+		{Select("*").From("t").GroupByCube("a"), dialect.MySQL},
+		{Select("*").From("t").GroupingSets([]interface{}{"a"}), dialect.MySQL},
+		{Select("*").From("t").GroupByRollup("a"), dialect.SQLite3},
+		{Select("*").From("t").GroupBy("a").WithTotals(), dialect.PostgreSQL},
+		{Select("*").From("t").GroupBy("a").GroupByRollup("b"), dialect.MySQL},
+		{Select("*").From("t").GroupByRollup("a").GroupByRollup("b"), dialect.MySQL},
+		{Select("*").From("t").GroupByCube("a").GroupBy("b"), dialect.ClickHouse},
+	} {
+		err := test.stmt.Build(test.dialect, NewBuffer())
+		assert.Equal(t, ErrNotSupported, err)
+	}
+}
+
+func TestGroupingInvalidColumn(t *testing.T) {
+	err := Select("*").From("t").GroupByRollup("a", 1).Build(dialect.PostgreSQL, NewBuffer())
+	assert.Equal(t, ErrInvalidGroupingColumn, err)
+	err = Select("*").From("t").GroupingSets([]interface{}{"a"}, []interface{}{true}).Build(dialect.PostgreSQL, NewBuffer())
+	assert.Equal(t, ErrInvalidGroupingColumn, err)
+}
@@ -2,35 +2,21 @@ package dbr
 
 import (
 	"bytes"
-	"fmt"
 	"reflect"
 	"sort"
 )
 
-// ConflictStmt is ` ON CONFLICT ...` part of InsertStmt
-type ConflictStmt interface {
-	Action(column string, action interface{}) ConflictStmt
-}
-
-type conflictStmt struct {
-	constraint string
-	actions    map[string]interface{}
-}
-
-// Action adds action for column which will do if conflict happens
-func (b *conflictStmt) Action(column string, action interface{}) ConflictStmt {
-	b.actions[column] = action
-	return b
-}
-
 // InsertStmt builds `INSERT INTO ...`
 type InsertStmt interface {
 	Builder
 	Columns(column ...string) InsertStmt
 	Values(value ...interface{}) InsertStmt
 	Record(structValue interface{}) InsertStmt
+	FromSelect(query SelectStmt) InsertStmt
 	OnConflictMap(constraint string, actions map[string]interface{}) InsertStmt
 	OnConflict(constraint string) ConflictStmt
+	OnConflictColumns(column ...string) ConflictStmt
+	Returning(column ...string) InsertStmt
 }
 
 type insertStmt struct {
@@ -40,14 +26,12 @@ type insertStmt struct {
 	Column   []string
 	Value    [][]interface{}
 	Conflict *conflictStmt
-}
 
-// Proposed is reference to proposed value in on conflict clause
-func Proposed(column string) Builder {
-	return BuildFunc(func(d Dialect, b Buffer) error {
-		_, err := b.WriteString(d.Proposed(column))
-		return err
-	})
+	IsReplace bool
+
+	SelectQuery SelectStmt
+
+	ReturnColumn []string
 }
 
 // Build builds `INSERT INTO ...` in dialect
@@ -60,13 +44,51 @@ func (b *insertStmt) Build(d Dialect, buf Buffer) error {
 		return ErrTableNotSpecified
 	}
 
-	if len(b.Column) == 0 {
+	if b.SelectQuery != nil {
+		if len(b.Value) > 0 {
+			return ErrValuesWithSelect
+		}
+	} else if len(b.Column) == 0 {
 		return ErrColumnNotSpecified
 	}
 
-	buf.WriteString("INSERT INTO ")
+	keyword, err := b.insertKeyword(d)
+	if err != nil {
+		return err
+	}
+	buf.WriteString(keyword)
+	buf.WriteString(" INTO ")
 	buf.WriteString(d.QuoteIdent(b.Table))
 
+	if b.SelectQuery != nil {
+		if len(b.Column) > 0 {
+			buf.WriteString(" (")
+			for i, col := range b.Column {
+				if i > 0 {
+					buf.WriteString(",")
+				}
+				buf.WriteString(d.QuoteIdent(col))
+			}
+			buf.WriteString(")")
+		}
+		buf.WriteString(" ")
+		if b.ambiguousSelect(d) {
+			// `ON` of conflict clause would be parsed as join constraint
+			buf.WriteString("SELECT * FROM (")
+			err := b.SelectQuery.Build(d, buf)
+			if err != nil {
+				return err
+			}
+			buf.WriteString(") WHERE true")
+		} else {
+			err := b.SelectQuery.Build(d, buf)
+			if err != nil {
+				return err
+			}
+		}
+		return b.buildConflict(d, buf)
+	}
+
 	placeholderBuf := new(bytes.Buffer)
 	placeholderBuf.WriteString("(")
 	buf.WriteString(" (")
@@ -90,30 +112,33 @@ func (b *insertStmt) Build(d Dialect, buf Buffer) error {
 
 		buf.WriteValue(tuple...)
 	}
-	if b.Conflict != nil && len(b.Conflict.actions) > 0 {
-		keyword := d.OnConflict(b.Conflict.constraint)
-		if len(keyword) == 0 {
-			return fmt.Errorf("Dialect %s does not support OnConflict", d)
+	return b.buildConflict(d, buf)
+}
+
+// ambiguousSelect reports whether SELECT followed by conflict clause needs WHERE in dialect
+func (b *insertStmt) ambiguousSelect(d Dialect) bool {
+	if b.Conflict == nil || !d.AmbiguousUpsertSelect() {
+		return false
+	}
+	stmt, ok := b.SelectQuery.(*selectStmt)
+	return !ok || stmt.raw.Query != "" || len(stmt.WhereCond) == 0
+}
+
+// insertKeyword returns `INSERT`, `REPLACE` or the keyword of dialect skipping conflicting rows
+func (b *insertStmt) insertKeyword(d Dialect) (string, error) {
+	if b.IsReplace {
+		keyword := d.Replace()
+		if len(keyword) == 0 || b.Conflict != nil {
+			return "", ErrNotSupported
 		}
-		buf.WriteString(" ")
-		buf.WriteString(keyword)
-		buf.WriteString(" ")
-		needComma := false
-		for _, column := range b.Column {
-			if v, ok := b.Conflict.actions[column]; ok {
-				if needComma {
-					buf.WriteString(",")
-				}
-				buf.WriteString(d.QuoteIdent(column))
-				buf.WriteString("=")
-				buf.WriteString(placeholder)
-				buf.WriteValue(v)
-				needComma = true
-			}
+		return keyword, nil
+	}
+	if b.Conflict != nil && b.Conflict.doNothing {
+		if keyword := d.InsertIgnore(); keyword != "" {
+			return keyword, nil
 		}
 	}
-
-	return nil
+	return "INSERT", nil
 }
 
 // InsertInto creates an InsertStmt
@@ -121,6 +146,18 @@ func InsertInto(table string) InsertStmt {
 	return createInsertStmt(table)
 }
 
+// ReplaceInto creates an InsertStmt for `REPLACE INTO ...`, which deletes rows conflicting with new ones
+func ReplaceInto(table string) InsertStmt {
+	return createReplaceStmt(table)
+}
+
+func createReplaceStmt(table string) *insertStmt {
+	return &insertStmt{
+		Table:     table,
+		IsReplace: true,
+	}
+}
+
 func createInsertStmt(table string) *insertStmt {
 	return &insertStmt{
 		Table: table,
@@ -187,8 +224,20 @@ func (b *insertStmt) Record(structValue interface{}) InsertStmt {
 	return b
 }
 
-// OnConflictMap allows to add actions for constraint violation, e.g UPSERT
+// FromSelect inserts rows selected by query `INSERT INTO ... SELECT ...`,
+// columns are optional and values can't be added
+func (b *insertStmt) FromSelect(query SelectStmt) InsertStmt {
+	b.SelectQuery = query
+	return b
+}
+
+// OnConflictMap allows to add actions for constraint violation, e.g UPSERT,
+// conflict clause is not added if there are no actions
 func (b *insertStmt) OnConflictMap(constraint string, actions map[string]interface{}) InsertStmt {
+	if len(actions) == 0 {
+		b.Conflict = nil
+		return b
+	}
 	b.Conflict = &conflictStmt{constraint: constraint, actions: actions}
 	return b
 }
@@ -198,3 +247,15 @@ func (b *insertStmt) OnConflict(constraint string) ConflictStmt {
 	b.Conflict = &conflictStmt{constraint: constraint, actions: make(map[string]interface{})}
 	return b.Conflict
 }
+
+// OnConflictColumns creates an empty OnConflict section with columns of unique index as conflict target
+func (b *insertStmt) OnConflictColumns(column ...string) ConflictStmt {
+	b.Conflict = &conflictStmt{column: column, actions: make(map[string]interface{})}
+	return b.Conflict
+}
+
+// Returning adds `RETURNING` clause, columns are loaded via Load* methods of InsertBuilder
+func (b *insertStmt) Returning(column ...string) InsertStmt {
+	b.ReturnColumn = append(b.ReturnColumn, column...)
+	return b
+}
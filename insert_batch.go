@@ -0,0 +1,125 @@
+package dbr
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// maxBatchBytes is the maximum size of a batch statement with its values,
+// it is the default max_allowed_packet of MySQL 5.7 and is far below limits of other databases
+const maxBatchBytes = 4 << 20
+
+// ExecBatch executes the stmt in batches of size records with background context
+func (b *insertBuilder) ExecBatch(size int) (int64, error) {
+	return b.ExecBatchContext(b.ctx, size)
+}
+
+// ExecBatchContext splits records into statements of at most size records and executes them one by one,
+// returning the total number of affected rows. size <= 0 means no limit of records.
+// Batches are also limited by the number of parameters supported by dialect (Dialect.MaxParams)
+// and by 4MB of statement size including values, values of conflict actions are counted as well.
+// Batches are executed in the transaction if the builder is created by Tx,
+// otherwise records of failed batch and following ones are not inserted.
+// Unlike Exec, ids of inserted records are not set. Nothing is executed if there are no records.
+func (b *insertBuilder) ExecBatchContext(ctx context.Context, size int) (int64, error) {
+	stmt := b.insertStmt
+	if stmt.raw.Query != "" || stmt.SelectQuery != nil {
+		result, err := b.ExecContext(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return result.RowsAffected()
+	}
+	if len(stmt.Column) == 0 {
+		return 0, ErrColumnNotSpecified
+	}
+	if len(stmt.Value) == 0 {
+		return 0, nil
+	}
+	if size <= 0 {
+		size = len(stmt.Value)
+	}
+
+	bind := bindParams(b.runner)
+	// statement without records shows what is taken by conflict and returning clauses
+	empty := *stmt
+	empty.Value = nil
+	baseParams, baseBytes, err := measureBatch(b.Dialect, bind, placeholder, &empty)
+	if err != nil {
+		return 0, err
+	}
+	tuple := "(" + strings.Repeat(placeholder+",", len(stmt.Column)-1) + placeholder + ")"
+	maxParams := b.Dialect.MaxParams()
+
+	var total int64
+	batch, start, params, bytes := 0, 0, baseParams, baseBytes
+	for i := 0; i <= len(stmt.Value); i++ {
+		var rowParams, rowBytes int
+		if i < len(stmt.Value) {
+			rowParams, rowBytes, err = measureBatch(b.Dialect, bind, tuple, stmt.Value[i]...)
+			if err != nil {
+				return total, err
+			}
+			rowBytes += len(", ")
+			full := i-start >= size ||
+				maxParams > 0 && params+rowParams > maxParams ||
+				bytes+rowBytes > maxBatchBytes
+			if !full || i == start {
+				params += rowParams
+				bytes += rowBytes
+				continue
+			}
+		}
+
+		chunk := *stmt
+		chunk.Value = stmt.Value[start:i]
+		affected, err := b.execBatch(ctx, &chunk)
+		if err != nil {
+			return total, err
+		}
+		total += affected
+		b.EventKv("dbr.exec.batch", kvs{
+			"batch":    strconv.Itoa(batch),
+			"records":  strconv.Itoa(i - start),
+			"affected": strconv.FormatInt(affected, 10),
+		})
+		batch, start, params, bytes = batch+1, i, baseParams+rowParams, baseBytes+rowBytes
+	}
+	return total, nil
+}
+
+func (b *insertBuilder) execBatch(ctx context.Context, stmt *insertStmt) (int64, error) {
+	result, err := exec(ctx, b.runner, b.EventReceiver, stmt, b.Dialect)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// measureBatch returns the number of parameters and the size in bytes of query with values
+// as they are sent to the database
+func measureBatch(d Dialect, bind bool, query string, value ...interface{}) (int, int, error) {
+	i := interpolator{
+		Buffer:       NewBuffer(),
+		Dialect:      d,
+		IgnoreBinary: true,
+		BindParams:   bind,
+	}
+	err := i.interpolate(query, value)
+	if err != nil {
+		return 0, 0, err
+	}
+	bytes := len(i.String())
+	for _, v := range i.Value() {
+		switch v := v.(type) {
+		case string:
+			bytes += len(v)
+		case []byte:
+			bytes += len(v)
+		default:
+			bytes += 8
+		}
+	}
+	return len(i.Value()), bytes, nil
+}
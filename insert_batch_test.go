@@ -0,0 +1,122 @@
+package dbr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+// batchRecords returns the number of records of every batch reported to log
+func batchRecords(log *testEventReceiver) []string {
+	var records []string
+	for i, name := range log.events {
+		if name == "dbr.exec.batch" {
+			records = append(records, log.kvs[i]["records"])
+		}
+	}
+	return records
+}
+
+func TestInsertExecBatch(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	log := &testEventReceiver{}
+	conn := Connection{DBConn: db, Dialect: dialect.MySQL, EventReceiver: log}
+	sess := conn.NewSession(nil)
+
+	dbmock.ExpectExec("INSERT INTO `table` \\(`a`\\) VALUES \\(1\\), \\(2\\)$").WillReturnResult(sqlmock.NewResult(0, 2))
+	dbmock.ExpectExec("INSERT INTO `table` \\(`a`\\) VALUES \\(3\\), \\(4\\)$").WillReturnResult(sqlmock.NewResult(0, 2))
+	dbmock.ExpectExec("INSERT INTO `table` \\(`a`\\) VALUES \\(5\\)$").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	stmt := sess.InsertInto("table").Columns("a")
+	for i := 1; i <= 5; i++ {
+		stmt.Values(i)
+	}
+	affected, err := stmt.ExecBatch(2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5, affected)
+
+	var batches []map[string]string
+	for i, name := range log.events {
+		if name == "dbr.exec.batch" {
+			batches = append(batches, log.kvs[i])
+		}
+	}
+	assert.Equal(t, []map[string]string{
+		{"batch": "0", "records": "2", "affected": "2"},
+		{"batch": "1", "records": "2", "affected": "2"},
+		{"batch": "2", "records": "1", "affected": "1"},
+	}, batches)
+
+	// no records, no statements
+	affected, err = sess.InsertInto("table").Columns("a").ExecBatch(2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, affected)
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestInsertExecBatchTx(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := Connection{DBConn: db, Dialect: dialect.MySQL, EventReceiver: nullReceiver}
+	sess := conn.NewSession(nil)
+
+	dbmock.ExpectBegin()
+	dbmock.ExpectExec("INSERT INTO `table` \\(`a`\\) VALUES \\(1\\)$").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbmock.ExpectExec("INSERT INTO `table` \\(`a`\\) VALUES \\(2\\)$").WillReturnError(sqlmock.ErrCancelled)
+	dbmock.ExpectRollback()
+
+	tx, err := sess.Begin()
+	assert.NoError(t, err)
+	defer tx.RollbackUnlessCommitted()
+
+	affected, err := tx.InsertInto("table").Columns("a").Values(1).Values(2).ExecBatch(1)
+	assert.Equal(t, sqlmock.ErrCancelled, err)
+	assert.EqualValues(t, 1, affected)
+	tx.RollbackUnlessCommitted()
+
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestInsertExecBatchLimits(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	// statement size is limited in interpolate mode
+	log := &testEventReceiver{}
+	conn := &Connection{DBConn: db, Dialect: dialect.MySQL, EventReceiver: log}
+	stmt := conn.NewSession(nil).InsertInto("table").Columns("a")
+	value := strings.Repeat("a", 1<<20)
+	for i := 0; i < 5; i++ {
+		stmt.Values(value)
+	}
+	dbmock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 3))
+	dbmock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 2))
+	affected, err := stmt.ExecBatch(0)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5, affected)
+	assert.Equal(t, []string{"3", "2"}, batchRecords(log))
+
+	// parameters of records and conflict actions are limited by dialect in BindParams mode
+	log = &testEventReceiver{}
+	conn = &Connection{DBConn: db, Dialect: dialect.SQLite3, EventReceiver: log, ParamMode: BindParams}
+	stmt = conn.NewSession(nil).InsertInto("table").Columns("a", "b")
+	for i := 0; i < 1000; i++ {
+		stmt.Values(i, i)
+	}
+	stmt.OnConflictColumns("a").Action("b", 0)
+	dbmock.ExpectPrepare("INSERT INTO")
+	dbmock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 499))
+	dbmock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 499))
+	dbmock.ExpectPrepare("INSERT INTO")
+	dbmock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 2))
+	affected, err = stmt.ExecBatch(0)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1000, affected)
+	assert.Equal(t, []string{"499", "499", "2"}, batchRecords(log))
+
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
@@ -11,12 +11,18 @@ type InsertBuilder interface {
 	Builder
 	EventReceiver
 	Executer
+	returningLoader
+	ExecBatch(size int) (int64, error)
+	ExecBatchContext(ctx context.Context, size int) (int64, error)
 	Columns(column ...string) InsertBuilder
 	Values(value ...interface{}) InsertBuilder
 	Record(structValue interface{}) InsertBuilder
+	FromSelect(query SelectStmt) InsertBuilder
 	OnConflictMap(constraint string, actions map[string]interface{}) InsertBuilder
 	OnConflict(constraint string) ConflictStmt
+	OnConflictColumns(column ...string) ConflictStmt
 	Pair(column string, value interface{}) InsertBuilder
+	Returning(column ...string) InsertBuilder
 }
 
 // InsertBuilder builds "INSERT ..." stmt
@@ -52,6 +58,28 @@ func (tx *Tx) InsertInto(table string) InsertBuilder {
 	}
 }
 
+// ReplaceInto creates a InsertBuilder for `REPLACE INTO ...`
+func (sess *Session) ReplaceInto(table string) InsertBuilder {
+	return &insertBuilder{
+		runner:        sess,
+		EventReceiver: sess.EventReceiver,
+		Dialect:       sess.Dialect,
+		insertStmt:    createReplaceStmt(table),
+		ctx:           sess.ctx,
+	}
+}
+
+// ReplaceInto creates a InsertBuilder for `REPLACE INTO ...`
+func (tx *Tx) ReplaceInto(table string) InsertBuilder {
+	return &insertBuilder{
+		runner:        tx,
+		EventReceiver: tx.EventReceiver,
+		Dialect:       tx.Dialect,
+		insertStmt:    createReplaceStmt(table),
+		ctx:           tx.ctx,
+	}
+}
+
 // InsertBySql creates a InsertBuilder from raw query
 func (sess *Session) InsertBySql(query string, value ...interface{}) InsertBuilder {
 	return &insertBuilder{
@@ -143,6 +171,12 @@ func (b *insertBuilder) Record(structValue interface{}) InsertBuilder {
 	return b
 }
 
+// FromSelect inserts rows selected by query `INSERT INTO ... SELECT ...`
+func (b *insertBuilder) FromSelect(query SelectStmt) InsertBuilder {
+	b.insertStmt.FromSelect(query)
+	return b
+}
+
 // OnConflictMap allows to add actions for constraint violation, e.g UPSERT
 func (b *insertBuilder) OnConflictMap(constraint string, actions map[string]interface{}) InsertBuilder {
 	b.insertStmt.OnConflictMap(constraint, actions)
@@ -153,3 +187,51 @@ func (b *insertBuilder) OnConflictMap(constraint string, actions map[string]inte
 func (b *insertBuilder) OnConflict(constraint string) ConflictStmt {
 	return b.insertStmt.OnConflict(constraint)
 }
+
+// OnConflictColumns creates an empty OnConflict section with columns of unique index as conflict target
+func (b *insertBuilder) OnConflictColumns(column ...string) ConflictStmt {
+	return b.insertStmt.OnConflictColumns(column...)
+}
+
+// Returning adds `RETURNING` clause
+func (b *insertBuilder) Returning(column ...string) InsertBuilder {
+	b.insertStmt.Returning(column...)
+	return b
+}
+
+// Load loads any value returned by `RETURNING` clause with background context
+func (b *insertBuilder) Load(value interface{}) (int, error) {
+	return b.LoadContext(b.ctx, value)
+}
+
+// LoadContext loads any value returned by `RETURNING` clause
+func (b *insertBuilder) LoadContext(ctx context.Context, value interface{}) (int, error) {
+	return query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+}
+
+// LoadStruct loads struct returned by `RETURNING` clause with background context, returns ErrNotFound if there is no result
+func (b *insertBuilder) LoadStruct(value interface{}) error {
+	return b.LoadStructContext(b.ctx, value)
+}
+
+// LoadStructContext loads struct returned by `RETURNING` clause, returns ErrNotFound if there is no result
+func (b *insertBuilder) LoadStructContext(ctx context.Context, value interface{}) error {
+	count, err := query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// LoadStructs loads structures returned by `RETURNING` clause with background context
+func (b *insertBuilder) LoadStructs(value interface{}) (int, error) {
+	return b.LoadStructsContext(b.ctx, value)
+}
+
+// LoadStructsContext loads structures returned by `RETURNING` clause
+func (b *insertBuilder) LoadStructsContext(ctx context.Context, value interface{}) (int, error) {
+	return query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+}
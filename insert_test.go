@@ -3,6 +3,7 @@ package dbr
 import (
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/mailru/dbr/dialect"
 	"github.com/stretchr/testify/assert"
 )
@@ -58,6 +59,126 @@ func TestInsertOnConflictMapStmt(t *testing.T) {
 	assert.Equal(t, []interface{}{1, "one", exp, "one"}, buf.Value())
 }
 
+func TestReplaceStmt(t *testing.T) {
+	buf := NewBuffer()
+	err := ReplaceInto("table").Columns("a", "b").Values(1, "one").Build(dialect.MySQL, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "REPLACE INTO `table` (`a`,`b`) VALUES (?,?)", buf.String())
+	assert.Equal(t, []interface{}{1, "one"}, buf.Value())
+
+	buf = NewBuffer()
+	err = ReplaceInto("table").Columns("a", "b").Record(&insertTest{A: 2, C: "two"}).Build(dialect.SQLite3, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, `REPLACE INTO "table" ("a","b") VALUES (?,?)`, buf.String())
+	assert.Equal(t, []interface{}{2, "two"}, buf.Value())
+
+	err = ReplaceInto("table").Columns("a").Values(1).Build(dialect.PostgreSQL, NewBuffer())
+	assert.Equal(t, ErrNotSupported, err)
+}
+
+func TestInsertFromSelectStmt(t *testing.T) {
+	for _, test := range []struct {
+		stmt    InsertStmt
+		dialect Dialect
+		query   string
+		value   []interface{}
+	}{
+		{
+			stmt: InsertInto("archive").Columns("id", "name").
+				FromSelect(Select("id", "name").From("events").Where(Lt("created_at", "2020-01-01"))),
+			dialect: dialect.MySQL,
+			query:   "INSERT INTO `archive` (`id`,`name`) SELECT id, name FROM events WHERE (`created_at` < ?)",
+			value:   []interface{}{"2020-01-01"},
+		},
+		{
+			stmt:    InsertInto("archive").FromSelect(Select("*").From("events")),
+			dialect: dialect.SQLite3,
+			query:   `INSERT INTO "archive" SELECT * FROM events`,
+		},
+		{
+			stmt: InsertInto("totals").Columns("id", "total").
+				FromSelect(Select("user_id", "SUM(amount)").From("payments").GroupBy("user_id")).
+				OnConflictMap("totals_pkey", map[string]interface{}{"total": Proposed("total")}).
+				Returning("id"),
+			dialect: dialect.PostgreSQL,
+			query:   `INSERT INTO "totals" ("id","total") SELECT user_id, SUM(amount) FROM payments GROUP BY user_id ON CONFLICT ON CONSTRAINT "totals_pkey" DO UPDATE SET "total"=? RETURNING "id"`,
+			value:   []interface{}{Proposed("total")},
+		},
+	} {
+		buf := NewBuffer()
+		err := test.stmt.Build(test.dialect, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, buf.String())
+		if test.value != nil {
+			assert.Len(t, buf.Value(), len(test.value))
+		} else {
+			assert.Empty(t, buf.Value())
+		}
+	}
+
+	err := InsertInto("archive").Columns("id").Values(1).FromSelect(Select("id").From("events")).Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrValuesWithSelect, err)
+}
+
+func TestInsertReturningStmt(t *testing.T) {
+	buf := NewBuffer()
+	builder := InsertInto("table").Columns("a", "b").Values(1, "one").Returning("id", "created_at")
+	err := builder.Build(dialect.PostgreSQL, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "table" ("a","b") VALUES (?,?) RETURNING "id", "created_at"`, buf.String())
+	assert.Equal(t, []interface{}{1, "one"}, buf.Value())
+
+	err = builder.Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrReturningNotSupported, err)
+}
+
+func TestInsertReturningLoad(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: nullReceiver}
+	sess := conn.NewSession(nil)
+
+	dbmock.ExpectQuery(`INSERT INTO "table" \("a"\) VALUES \(1\),\ \(2\) RETURNING "id", "a"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "a"}).AddRow(10, 1).AddRow(11, 2))
+
+	var records []struct {
+		ID int64
+		A  int
+	}
+	count, err := sess.InsertInto("table").Columns("a").Values(1).Values(2).Returning("id", "a").LoadStructs(&records)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	if assert.Len(t, records, 2) {
+		assert.EqualValues(t, 10, records[0].ID)
+		assert.EqualValues(t, 11, records[1].ID)
+	}
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestReturningSQLite(t *testing.T) {
+	sess := sqlite3Session
+	reset(sess)
+
+	var ids []int64
+	_, err := sess.InsertInto("dbr_people").Columns("name", "email").
+		Values("a", "a@example.com").
+		Values("b", "b@example.com").
+		Returning("id").Load(&ids)
+	assert.NoError(t, err)
+	assert.Len(t, ids, 2)
+
+	var email string
+	_, err = sess.Update("dbr_people").Set("email", "c@example.com").Where(Eq("name", "a")).
+		Returning("email").Load(&email)
+	assert.NoError(t, err)
+	assert.Equal(t, "c@example.com", email)
+
+	var names []string
+	_, err = sess.DeleteFrom("dbr_people").Where(Eq("id", ids[1])).Returning("name").Load(&names)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, names)
+}
+
 func BenchmarkInsertValuesSQL(b *testing.B) {
 	buf := NewBuffer()
 	for i := 0; i < b.N; i++ {
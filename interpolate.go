@@ -14,7 +14,10 @@ type interpolator struct {
 	Buffer
 	Dialect
 	IgnoreBinary bool
-	N            int
+	// BindParams writes placeholders for all values except builders,
+	// slices and maps are expanded into multiple placeholders
+	BindParams bool
+	N          int
 }
 
 // InterpolateForDialect replaces placeholder in query with corresponding value in dialect
@@ -31,36 +34,54 @@ func InterpolateForDialect(query string, value []interface{}, d Dialect) (string
 }
 
 func (i *interpolator) interpolate(query string, value []interface{}) error {
-	if strings.Count(query, placeholder) != len(value) {
-		return ErrPlaceholderCount
-	}
-
 	valueIndex := 0
 
-	for {
-		index := strings.Index(query, placeholder)
-		if index == -1 {
-			break
+	err := newLexer(i.Dialect).split(query, func(part string, code bool) error {
+		if !code {
+			i.WriteString(part)
+			return nil
 		}
+		for {
+			index := strings.Index(part, placeholder)
+			if index == -1 {
+				break
+			}
 
-		i.WriteString(query[:index])
-		if _, ok := value[valueIndex].([]byte); ok && i.IgnoreBinary {
-			i.WriteString(i.Placeholder(i.N))
-			i.N++
-			i.WriteValue(value[valueIndex])
-		} else {
-			err := i.encodePlaceholder(value[valueIndex])
-			if err != nil {
-				return err
+			i.WriteString(part[:index])
+			part = part[index+len(placeholder):]
+			if strings.HasPrefix(part, placeholder) {
+				// `??` is an escaped question mark
+				i.WriteString(placeholder)
+				part = part[len(placeholder):]
+				continue
 			}
-		}
-		query = query[index+len(placeholder):]
-		valueIndex++
-	}
 
-	// placeholder not found; write remaining query
-	i.WriteString(query)
+			if valueIndex == len(value) {
+				return ErrPlaceholderCount
+			}
+			if _, ok := value[valueIndex].([]byte); ok && i.IgnoreBinary {
+				i.WriteString(i.Placeholder(i.N))
+				i.N++
+				i.WriteValue(value[valueIndex])
+			} else {
+				err := i.encodePlaceholder(value[valueIndex])
+				if err != nil {
+					return err
+				}
+			}
+			valueIndex++
+		}
 
+		// placeholder not found; write remaining part
+		i.WriteString(part)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if valueIndex != len(value) {
+		return ErrPlaceholderCount
+	}
 	return nil
 }
 
@@ -74,7 +95,7 @@ func (i *interpolator) encodePlaceholder(value interface{}) error {
 		paren := true
 		switch value.(type) {
 		case SelectStmt:
-		case *union:
+		case *compound:
 		default:
 			paren = false
 		}
@@ -91,6 +112,13 @@ func (i *interpolator) encodePlaceholder(value interface{}) error {
 		return nil
 	}
 
+	if i.BindParams && !isExpandable(value) {
+		i.WriteString(i.Placeholder(i.N))
+		i.N++
+		i.WriteValue(value)
+		return nil
+	}
+
 	if valuer, ok := value.(driver.Valuer); ok {
 		// get driver.Valuer's data
 		var err error
@@ -180,6 +208,22 @@ func (i *interpolator) encodePlaceholder(value interface{}) error {
 	return ErrNotSupported
 }
 
+// isExpandable reports whether value is a slice or a map,
+// which is written as a list of values
+func isExpandable(value interface{}) bool {
+	if _, ok := value.(driver.Valuer); ok {
+		return false
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice:
+		return v.Type().Elem().Kind() != reflect.Uint8
+	case reflect.Map:
+		return true
+	}
+	return false
+}
+
 type mapKeys []reflect.Value
 
 func (k mapKeys) Len() int {
@@ -0,0 +1,122 @@
+package dbr
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+)
+
+// Iterator streams query result row by row instead of loading it at once
+type Iterator interface {
+	// Next prepares the next row for Scan, returns false when there are no more rows or an error happened
+	Next() bool
+	// Scan loads the current row into value in the same way as Load does for a single element
+	Scan(value interface{}) error
+	// Err returns the error, if any, that was encountered during iteration
+	Err() error
+	// Close closes the iterator, it is called automatically when Next returns false
+	Close() error
+}
+
+type iterator struct {
+	rows       *sql.Rows
+	log        EventReceiver
+	query      string
+	column     []string
+	extractor  map[reflect.Type]pointersExtractor
+	afterScan  func(value reflect.Value)
+	finish     func(error)
+	isFinished bool
+}
+
+func iterate(ctx context.Context, runner runner, log EventReceiver, builder Builder, d Dialect) (*iterator, error) {
+	rows, query, finish, err := openRows(ctx, runner, log, builder, d)
+	if err != nil {
+		return nil, err
+	}
+
+	column, err := rows.Columns()
+	if err != nil {
+		rows.Close()
+		finish(err)
+		return nil, log.EventErrKv("dbr.select.load.scan", err, kvs{
+			"sql": query,
+		})
+	}
+
+	return &iterator{
+		rows:      rows,
+		log:       log,
+		query:     query,
+		column:    column,
+		extractor: make(map[reflect.Type]pointersExtractor),
+		finish:    finish,
+	}, nil
+}
+
+// Next prepares the next row for Scan
+func (it *iterator) Next() bool {
+	if it.isFinished {
+		return false
+	}
+	if it.rows.Next() {
+		return true
+	}
+	it.Close()
+	return false
+}
+
+// Scan loads the current row into value
+func (it *iterator) Scan(value interface{}) error {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return ErrInvalidPointer
+	}
+
+	elem := v.Elem()
+	extractor, ok := it.extractor[elem.Type()]
+	if !ok {
+		var err error
+		extractor, err = findExtractor(elem.Type())
+		if err != nil {
+			return err
+		}
+		it.extractor[elem.Type()] = extractor
+	}
+
+	err := it.rows.Scan(extractor(it.column, elem)...)
+	if err != nil {
+		return it.log.EventErrKv("dbr.select.load.scan", err, kvs{
+			"sql": it.query,
+		})
+	}
+	if it.afterScan != nil {
+		it.afterScan(v)
+	}
+	return nil
+}
+
+// Err returns the error, if any, that was encountered during iteration
+func (it *iterator) Err() error {
+	return it.rows.Err()
+}
+
+// Close closes the iterator and reports query timing
+func (it *iterator) Close() error {
+	if it.isFinished {
+		return nil
+	}
+	it.isFinished = true
+
+	err := it.rows.Close()
+	if err == nil {
+		err = it.rows.Err()
+	}
+	it.finish(err)
+	if err != nil {
+		return it.log.EventErrKv("dbr.select.load.scan", err, kvs{
+			"sql": it.query,
+		})
+	}
+	return nil
+}
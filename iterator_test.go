@@ -0,0 +1,119 @@
+package dbr
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+// testEventReceiver records names of received events
+type testEventReceiver struct {
+	NullEventReceiver
+	events  []string
+	kvs     []map[string]string
+	started int
+	errored int
+	done    int
+}
+
+func (r *testEventReceiver) Event(eventName string) {
+	r.events = append(r.events, eventName)
+}
+
+func (r *testEventReceiver) EventKv(eventName string, kvs map[string]string) {
+	r.events = append(r.events, eventName)
+	r.kvs = append(r.kvs, kvs)
+}
+
+func (r *testEventReceiver) EventErr(eventName string, err error) error {
+	r.events = append(r.events, eventName)
+	return err
+}
+
+func (r *testEventReceiver) EventErrKv(eventName string, err error, kvs map[string]string) error {
+	r.events = append(r.events, eventName)
+	r.kvs = append(r.kvs, kvs)
+	return err
+}
+
+func (r *testEventReceiver) TimingKv(eventName string, nanoseconds int64, kvs map[string]string) {
+	r.events = append(r.events, eventName)
+	r.kvs = append(r.kvs, kvs)
+}
+
+func (r *testEventReceiver) SpanStart(ctx context.Context, eventName, query string) context.Context {
+	r.started++
+	return ctx
+}
+
+func (r *testEventReceiver) SpanError(ctx context.Context, err error) {
+	r.errored++
+}
+
+func (r *testEventReceiver) SpanFinish(ctx context.Context) {
+	r.done++
+}
+
+func TestIterate(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	recv := &testEventReceiver{}
+	conn := Connection{DBConn: db, Dialect: dialect.MySQL, EventReceiver: recv}
+	sess := conn.NewSession(nil)
+
+	dbmock.ExpectQuery("SELECT id, name FROM people").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a").AddRow(2, "b"))
+
+	it, err := sess.Select("id", "name").From("people").Iterate()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, recv.started)
+	assert.Empty(t, recv.events)
+
+	var people []person
+	for it.Next() {
+		var p person
+		assert.NoError(t, it.Scan(&p))
+		people = append(people, p)
+	}
+	assert.NoError(t, it.Err())
+	assert.NoError(t, it.Close())
+
+	if assert.Len(t, people, 2) {
+		assert.Equal(t, person{ID: 1, Name: "a"}, people[0])
+		assert.Equal(t, person{ID: 2, Name: "b"}, people[1])
+	}
+	assert.Equal(t, []string{"dbr.select"}, recv.events)
+	assert.Equal(t, 1, recv.done)
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestIterateError(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	recv := &testEventReceiver{}
+	conn := Connection{DBConn: db, Dialect: dialect.MySQL, EventReceiver: recv}
+	sess := conn.NewSession(nil)
+
+	rowErr := errors.New("row error")
+	dbmock.ExpectQuery("SELECT id FROM people").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).RowError(1, rowErr))
+
+	it, err := sess.Select("id").From("people").Iterate()
+	assert.NoError(t, err)
+
+	var ids []int64
+	for it.Next() {
+		var id int64
+		assert.NoError(t, it.Scan(&id))
+		ids = append(ids, id)
+	}
+	assert.Equal(t, []int64{1}, ids)
+	assert.Equal(t, rowErr, it.Err())
+	assert.Equal(t, []string{"dbr.select", "dbr.select.load.scan"}, recv.events)
+	assert.Equal(t, 1, recv.errored)
+	assert.Equal(t, 1, recv.done)
+}
@@ -0,0 +1,172 @@
+package dbr
+
+import (
+	"strings"
+
+	"github.com/mailru/dbr/dialect"
+)
+
+// lexer splits sql query into code and parts which should be left untouched,
+// like string literals, quoted identifiers and comments
+type lexer struct {
+	dialect.Syntax
+}
+
+func newLexer(d Dialect) lexer {
+	return lexer{d.Syntax()}
+}
+
+// split calls fn for every part of query in order,
+// code is false for string literals, quoted identifiers and comments
+func (l lexer) split(query string, fn func(part string, code bool) error) error {
+	start := 0
+	for i := 0; i < len(query); {
+		n := l.literalLen(query, i)
+		if n == 0 {
+			i++
+			continue
+		}
+		if start < i {
+			err := fn(query[start:i], true)
+			if err != nil {
+				return err
+			}
+		}
+		err := fn(query[i:i+n], false)
+		if err != nil {
+			return err
+		}
+		i += n
+		start = i
+	}
+	if start < len(query) {
+		return fn(query[start:], true)
+	}
+	return nil
+}
+
+// hasPlaceholder reports whether query has placeholders outside of literals and comments
+func (l lexer) hasPlaceholder(query string) bool {
+	found := false
+	l.split(query, func(part string, code bool) error {
+		if code && strings.Contains(strings.Replace(part, placeholder+placeholder, "", -1), placeholder) {
+			found = true
+		}
+		return nil
+	})
+	return found
+}
+
+// literalLen returns length of string literal, quoted identifier or comment
+// starting at query[i], or 0 if there is none
+func (l lexer) literalLen(query string, i int) int {
+	s := query[i:]
+	switch s[0] {
+	case '\'', '"':
+		return quotedLen(s, l.BackslashEscapes)
+	case '`':
+		return quotedLen(s, false)
+	case 'E', 'e':
+		if l.EscapeStrings && len(s) > 1 && s[1] == '\'' && (i == 0 || !isIdentByte(query[i-1])) {
+			return quotedLen(s[1:], true) + 1
+		}
+	case '[':
+		if l.BracketIdentifiers {
+			return untilLen(s, "]")
+		}
+	case '#':
+		if l.HashComments {
+			return untilLen(s, "\n")
+		}
+	case '-':
+		if strings.HasPrefix(s, "--") {
+			if l.DashCommentSpace && len(s) > 2 && s[2] > ' ' {
+				return 0
+			}
+			return untilLen(s, "\n")
+		}
+	case '/':
+		if strings.HasPrefix(s, "/*") {
+			return commentLen(s, l.NestedComments)
+		}
+	case '$':
+		if l.DollarQuotes && (i == 0 || !isIdentByte(query[i-1])) {
+			return dollarQuotedLen(s)
+		}
+	}
+	return 0
+}
+
+// quotedLen returns length of quoted s, quotes are escaped by doubling
+// and optionally by backslash
+func quotedLen(s string, backslashEscapes bool) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if backslashEscapes {
+				i++
+			}
+		case quote:
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// untilLen returns length of s up to and including end
+func untilLen(s, end string) int {
+	index := strings.Index(s[1:], end)
+	if index == -1 {
+		return len(s)
+	}
+	return index + 1 + len(end)
+}
+
+// commentLen returns length of /* ... */ comment
+func commentLen(s string, nested bool) int {
+	depth := 0
+	for i := 0; i+1 < len(s); i++ {
+		switch {
+		case s[i] == '/' && s[i+1] == '*' && (depth == 0 || nested):
+			depth++
+			i++
+		case s[i] == '*' && s[i+1] == '/':
+			depth--
+			i++
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(s)
+}
+
+// dollarQuotedLen returns length of $tag$...$tag$ string,
+// or 0 if s does not start with a tag
+func dollarQuotedLen(s string) int {
+	n := 1
+	for ; n < len(s) && s[n] != '$'; n++ {
+		if !isIdentByte(s[n]) || n == 1 && '0' <= s[n] && s[n] <= '9' {
+			// $1 is a positional parameter
+			return 0
+		}
+	}
+	if n == len(s) {
+		return 0
+	}
+	tag := s[:n+1]
+	index := strings.Index(s[len(tag):], tag)
+	if index == -1 {
+		return len(s)
+	}
+	return len(tag) + index + len(tag)
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c >= 0x80
+}
@@ -0,0 +1,110 @@
+package dbr
+
+import (
+	"testing"
+
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpolateSkipsLiterals(t *testing.T) {
+	for _, test := range []struct {
+		dialect Dialect
+		query   string
+		value   []interface{}
+		want    string
+	}{
+		{
+			dialect: dialect.MySQL,
+			query:   "SELECT 'what?', \"why?\", `who?` FROM t WHERE id = ?",
+			value:   []interface{}{1},
+			want:    "SELECT 'what?', \"why?\", `who?` FROM t WHERE id = 1",
+		},
+		{
+			dialect: dialect.MySQL,
+			query:   "SELECT 'it\\'s ?', 'it''s ?' FROM t -- why?\nWHERE id = ? # how?",
+			value:   []interface{}{1},
+			want:    "SELECT 'it\\'s ?', 'it''s ?' FROM t -- why?\nWHERE id = 1 # how?",
+		},
+		{
+			// wrapped dialect keeps lexing rules
+			dialect: noRowValues{dialect.MySQL},
+			query:   "SELECT 'it\\'s ?' FROM t WHERE id = ? # how?",
+			value:   []interface{}{1},
+			want:    "SELECT 'it\\'s ?' FROM t WHERE id = 1 # how?",
+		},
+		{
+			dialect: dialect.MySQL,
+			query:   "SELECT 1--?",
+			value:   []interface{}{1},
+			want:    "SELECT 1--1",
+		},
+		{
+			dialect: dialect.MySQL,
+			query:   "SELECT /* what? */ ?",
+			value:   []interface{}{1},
+			want:    "SELECT /* what? */ 1",
+		},
+		{
+			dialect: dialect.PostgreSQL,
+			query:   "SELECT * FROM t WHERE data ??| array['a'] AND data ?? 'b' AND id = ?",
+			value:   []interface{}{1},
+			want:    "SELECT * FROM t WHERE data ?| array['a'] AND data ? 'b' AND id = 1",
+		},
+		{
+			dialect: dialect.PostgreSQL,
+			query:   "SELECT $$what?$$, $fn$ why? $$ $fn$, E'it\\'s ?', 'it\\', ? /* a /* b? */ c? */",
+			value:   []interface{}{1},
+			want:    "SELECT $$what?$$, $fn$ why? $$ $fn$, E'it\\'s ?', 'it\\', 1 /* a /* b? */ c? */",
+		},
+		{
+			dialect: dialect.PostgreSQL,
+			query:   "SELECT $1, a$b, ?",
+			value:   []interface{}{1},
+			want:    "SELECT $1, a$b, 1",
+		},
+		{
+			dialect: dialect.SQLite3,
+			query:   "SELECT [what?], ? # comment",
+			value:   []interface{}{1},
+			want:    "SELECT [what?], 1 # comment",
+		},
+		{
+			dialect: dialect.ClickHouse,
+			query:   "SELECT 'it\\'s ?', ?",
+			value:   []interface{}{1},
+			want:    "SELECT 'it\\'s ?', 1",
+		},
+		{
+			dialect: dialect.MySQL,
+			query:   "SELECT 'unterminated ?",
+			want:    "SELECT 'unterminated ?",
+		},
+	} {
+		s, err := InterpolateForDialect(test.query, test.value, test.dialect)
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, s)
+	}
+}
+
+func TestInterpolatePlaceholderCount(t *testing.T) {
+	for _, test := range []struct {
+		query string
+		value []interface{}
+	}{
+		{query: "SELECT '?'", value: []interface{}{1}},
+		{query: "SELECT ??", value: []interface{}{1}},
+		{query: "SELECT ?, ?", value: []interface{}{1}},
+	} {
+		_, err := InterpolateForDialect(test.query, test.value, dialect.MySQL)
+		assert.Equal(t, ErrPlaceholderCount, err)
+	}
+}
+
+func TestNamedParametersSkipLiterals(t *testing.T) {
+	buf := NewBuffer()
+	err := Expr("SELECT ':id', \"@id\" -- :id\nWHERE id = :id AND '?' <> ''", map[string]int{"id": 1}).Build(dialect.PostgreSQL, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT ':id', \"@id\" -- :id\nWHERE id = ? AND '?' <> ''", buf.String())
+	assert.Equal(t, []interface{}{1}, buf.Value())
+}
@@ -0,0 +1,108 @@
+package dbr
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"reflect"
+	"time"
+)
+
+// isNamedValue reports whether value can be used to bind named parameters,
+// it should be a map with string keys or a struct
+func isNamedValue(value interface{}) bool {
+	switch value.(type) {
+	case Builder, driver.Valuer, time.Time, *time.Time:
+		return false
+	}
+	v, kind := extractOriginal(reflect.ValueOf(value))
+	switch kind {
+	case reflect.Map:
+		return v.Type().Key().Kind() == reflect.String
+	case reflect.Struct:
+		return true
+	}
+	return false
+}
+
+// bindNamed replaces named parameters like `:name` or `@name` in query with placeholders
+// and returns their values taken from the map or the struct.
+// Struct fields are matched by the same rules as in Load.
+func bindNamed(d Dialect, query string, arg interface{}) (string, []interface{}, error) {
+	v, kind := extractOriginal(reflect.ValueOf(arg))
+
+	var lookup func(name string) (interface{}, bool)
+	switch kind {
+	case reflect.Map:
+		lookup = func(name string) (interface{}, bool) {
+			value := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+			if !value.IsValid() {
+				return nil, false
+			}
+			return value.Interface(), true
+		}
+	case reflect.Struct:
+		m := structMap(v.Type())
+		lookup = func(name string) (interface{}, bool) {
+			index, ok := m[name]
+			if !ok {
+				return nil, false
+			}
+			return v.FieldByIndex(index).Interface(), true
+		}
+	default:
+		return "", nil, ErrNotSupported
+	}
+
+	buf := new(bytes.Buffer)
+	var value []interface{}
+	err := newLexer(d).split(query, func(part string, code bool) error {
+		if !code {
+			buf.WriteString(part)
+			return nil
+		}
+		for i := 0; i < len(part); i++ {
+			c := part[i]
+			switch c {
+			case ':', '@':
+				if i+1 < len(part) && part[i+1] == c {
+					// `::` type cast or `@@` system variable
+					buf.WriteString(part[i : i+2])
+					i++
+					continue
+				}
+				n := namedParameterLen(part[i+1:])
+				if n == 0 {
+					break
+				}
+				v, ok := lookup(part[i+1 : i+1+n])
+				if !ok {
+					return ErrNamedParameterNotFound
+				}
+				buf.WriteString(placeholder)
+				value = append(value, v)
+				i += n
+				continue
+			}
+			buf.WriteByte(c)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return buf.String(), value, nil
+}
+
+// namedParameterLen returns length of parameter name at the beginning of s
+func namedParameterLen(s string) int {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case '0' <= c && c <= '9' && i > 0:
+		default:
+			return i
+		}
+	}
+	return len(s)
+}
@@ -0,0 +1,62 @@
+package dbr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedParameters(t *testing.T) {
+	type params struct {
+		UserID int64
+		Name   string `db:"user_name"`
+	}
+
+	for _, test := range []struct {
+		builder Builder
+		query   string
+	}{
+		{
+			builder: Expr("id = :id OR parent_id = :id", map[string]interface{}{"id": 1}),
+			query:   "id = 1 OR parent_id = 1",
+		},
+		{
+			builder: Expr("user_id = @user_id AND name = @user_name", params{UserID: 2, Name: "a"}),
+			query:   "user_id = 2 AND name = 'a'",
+		},
+		{
+			builder: Expr("user_id = :user_id", &params{UserID: 3}),
+			query:   "user_id = 3",
+		},
+		{
+			builder: Select("*").From("t").Where("a::text = :name AND b = ':name' AND @@autocommit", map[string]string{"name": "x"}),
+			query:   "SELECT * FROM t WHERE (a::text = 'x' AND b = ':name' AND @@autocommit)",
+		},
+		{
+			builder: SelectBySql("SELECT * FROM t WHERE id = :id", map[string]interface{}{"id": []int{1, 2}}),
+			query:   "SELECT * FROM t WHERE id = (1,2)",
+		},
+		{
+			builder: Expr("id IN ?", map[string]interface{}{"a": 1}),
+			query:   "id IN ('a')",
+		},
+		{
+			builder: Expr("created_at > ?", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			query:   "created_at > '2020-01-01 00:00:00.000000'",
+		},
+	} {
+		buf := NewBuffer()
+		err := test.builder.Build(dialect.MySQL, buf)
+		assert.NoError(t, err)
+		query, err := InterpolateForDialect(buf.String(), buf.Value(), dialect.MySQL)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, query)
+	}
+}
+
+func TestNamedParameterNotFound(t *testing.T) {
+	err := Expr("id = :id", map[string]interface{}{"user_id": 1}).Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrNamedParameterNotFound, err)
+}
@@ -1,5 +1,7 @@
 package dbr
 
+import "strings"
+
 type direction bool
 
 // orderby directions
@@ -9,16 +11,59 @@ const (
 	desc           = true
 )
 
+type orderBy struct {
+	column string
+	dir    direction
+}
+
 func order(column string, dir direction) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		// FIXME: no quote ident
-		buf.WriteString(column)
-		switch dir {
-		case asc:
-			buf.WriteString(" ASC")
-		case desc:
-			buf.WriteString(" DESC")
+	return &orderBy{column: column, dir: dir}
+}
+
+func (o *orderBy) Build(d Dialect, buf Buffer) error {
+	// FIXME: no quote ident
+	buf.WriteString(o.column)
+	switch o.dir {
+	case asc:
+		buf.WriteString(" ASC")
+	case desc:
+		buf.WriteString(" DESC")
+	}
+	return nil
+}
+
+// parseOrder returns column and direction of ORDER BY item,
+// raw items like `id` or `t.id DESC` are parsed
+func parseOrder(b Builder) (*orderBy, bool) {
+	switch b := b.(type) {
+	case *orderBy:
+		return b, true
+	case *raw:
+		if len(b.Value) > 0 {
+			return nil, false
+		}
+		field := strings.Fields(b.Query)
+		if len(field) == 0 || !isColumnName(field[0]) {
+			return nil, false
+		}
+		switch {
+		case len(field) == 1:
+			return &orderBy{column: field[0], dir: asc}, true
+		case len(field) == 2 && strings.EqualFold(field[1], "ASC"):
+			return &orderBy{column: field[0], dir: asc}, true
+		case len(field) == 2 && strings.EqualFold(field[1], "DESC"):
+			return &orderBy{column: field[0], dir: desc}, true
+		}
+	}
+	return nil, false
+}
+
+// isColumnName reports whether s is a column name, which can be qualified by table name
+func isColumnName(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] != '.' && !isIdentByte(s[i]) {
+			return false
 		}
-		return nil
-	})
+	}
+	return true
 }
@@ -0,0 +1,82 @@
+package dbr
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/mailru/dbr/dialect"
+)
+
+// RetryPolicy configures how Session.RunInTx retries failed transactions
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts to run a transaction
+	MaxAttempts int
+	// Backoff returns delay before the given retry, attempt starts from 1
+	Backoff func(attempt int) time.Duration
+	// Retryable reports whether a transaction failed with err can be run again
+	Retryable func(d Dialect, err error) bool
+}
+
+// DefaultRetryPolicy is used by RunInTx unless Connection.RetryPolicy is set
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     ExponentialBackoff(10*time.Millisecond, time.Second),
+	Retryable:   IsRetryableError,
+}
+
+// ExponentialBackoff doubles delay on every retry starting from min until max is reached
+func ExponentialBackoff(min, max time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		delay := min
+		for i := 1; i < attempt && delay < max; i++ {
+			delay *= 2
+		}
+		if delay > max {
+			return max
+		}
+		return delay
+	}
+}
+
+// IsRetryableError reports whether err is a deadlock or a serialization failure,
+// so the transaction failed with it can be run again
+func IsRetryableError(d Dialect, err error) bool {
+	if err == nil {
+		return false
+	}
+	switch d {
+	case dialect.MySQL, dialect.MySQL8:
+		// ER_LOCK_DEADLOCK
+		return mysqlErrorNumber(err) == 1213
+	case dialect.PostgreSQL:
+		// serialization_failure and deadlock_detected
+		code := sqlState(err)
+		return code == "40001" || code == "40P01"
+	}
+	return false
+}
+
+// mysqlErrorNumber returns Number of github.com/go-sql-driver/mysql.MySQLError
+func mysqlErrorNumber(err error) uint16 {
+	v := reflect.Indirect(reflect.ValueOf(err))
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+	number := v.FieldByName("Number")
+	if !number.IsValid() || number.Kind() != reflect.Uint16 {
+		return 0
+	}
+	return uint16(number.Uint())
+}
+
+// sqlState returns SQLSTATE code of PostgreSQL drivers errors
+func sqlState(err error) string {
+	switch err := err.(type) {
+	case interface{ SQLState() string }:
+		return err.SQLState()
+	case interface{ Get(k byte) string }:
+		// github.com/lib/pq.Error
+		return err.Get('C')
+	}
+	return ""
+}
@@ -0,0 +1,40 @@
+package dbr
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	for _, test := range []struct {
+		d    Dialect
+		err  error
+		want bool
+	}{
+		{d: dialect.MySQL, err: &mysql.MySQLError{Number: 1213}, want: true},
+		{d: dialect.MySQL, err: &mysql.MySQLError{Number: 1062}, want: false},
+		{d: dialect.MySQL, err: errors.New("Error 1213"), want: false},
+		{d: dialect.PostgreSQL, err: &pq.Error{Code: "40001"}, want: true},
+		{d: dialect.PostgreSQL, err: &pq.Error{Code: "40P01"}, want: true},
+		{d: dialect.PostgreSQL, err: &pq.Error{Code: "23505"}, want: false},
+		{d: dialect.SQLite3, err: &pq.Error{Code: "40001"}, want: false},
+		{d: dialect.PostgreSQL, err: nil, want: false},
+	} {
+		assert.Equal(t, test.want, IsRetryableError(test.d, test.err), "%v", test.err)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond)
+	assert.Equal(t, 10*time.Millisecond, backoff(1))
+	assert.Equal(t, 20*time.Millisecond, backoff(2))
+	assert.Equal(t, 40*time.Millisecond, backoff(3))
+	assert.Equal(t, 50*time.Millisecond, backoff(4))
+	assert.Equal(t, 50*time.Millisecond, backoff(100))
+}
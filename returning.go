@@ -0,0 +1,28 @@
+package dbr
+
+// buildReturning builds ` RETURNING ...` part of INSERT, UPDATE and DELETE statements
+func buildReturning(d Dialect, buf Buffer, column []string) error {
+	if len(column) == 0 {
+		return nil
+	}
+
+	keyword := d.Returning()
+	if len(keyword) == 0 {
+		return ErrReturningNotSupported
+	}
+
+	buf.WriteString(" ")
+	buf.WriteString(keyword)
+	buf.WriteString(" ")
+	for i, col := range column {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if col == "*" {
+			buf.WriteString(col)
+		} else {
+			buf.WriteString(d.QuoteIdent(col))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package dbr
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/gob"
+	"reflect"
+	"strings"
+	"time"
+)
+
+func init() {
+	gob.Register(time.Time{})
+}
+
+// Cursor is a position in ordered rows, it holds values of ORDER BY columns of a row
+type Cursor []interface{}
+
+type seek struct {
+	cursor Cursor
+	before bool
+}
+
+// seekOrder returns ORDER BY columns used for seek pagination
+func (b *selectStmt) seekOrder() ([]*orderBy, error) {
+	if len(b.Order) == 0 {
+		return nil, ErrInvalidSeekOrder
+	}
+	order := make([]*orderBy, len(b.Order))
+	for i, o := range b.Order {
+		var ok bool
+		order[i], ok = parseOrder(o)
+		if !ok {
+			return nil, ErrInvalidSeekOrder
+		}
+	}
+	return order, nil
+}
+
+// buildSeek returns condition selecting rows after or before the cursor
+// and ORDER BY for them, which is reversed for rows before the cursor
+func (b *selectStmt) buildSeek() (Builder, []Builder, error) {
+	order, err := b.seekOrder()
+	if err != nil {
+		return nil, nil, err
+	}
+	cursor := b.Seek.cursor
+	if len(cursor) != len(order) {
+		return nil, nil, ErrInvalidCursor
+	}
+
+	// rows after the cursor are greater for asc columns and less for desc ones
+	pred := func(dir direction) string {
+		if (dir == asc) != b.Seek.before {
+			return ">"
+		}
+		return "<"
+	}
+
+	var cond Builder
+	if len(order) == 1 {
+		cond = cmp(pred(order[0].dir), order[0].column, cursor[0])
+	} else if sameDirection(order) {
+		column := make([]string, len(order))
+		for i, o := range order {
+			column[i] = o.column
+		}
+		if pred(order[0].dir) == ">" {
+			cond = TupleGt(column, cursor)
+		} else {
+			cond = TupleLt(column, cursor)
+		}
+	} else {
+		or := make([]Builder, len(order))
+		for i := range order {
+			and := make([]Builder, i+1)
+			for j := 0; j < i; j++ {
+				and[j] = cmp("=", order[j].column, cursor[j])
+			}
+			and[i] = cmp(pred(order[i].dir), order[i].column, cursor[i])
+			if i == 0 {
+				or[i] = and[0]
+			} else {
+				or[i] = And(and...)
+			}
+		}
+		cond = Or(or...)
+	}
+
+	if !b.Seek.before {
+		return cond, b.Order, nil
+	}
+	reversed := make([]Builder, len(order))
+	for i, o := range order {
+		reversed[i] = &orderBy{column: o.column, dir: !o.dir}
+	}
+	return cond, reversed, nil
+}
+
+func sameDirection(order []*orderBy) bool {
+	for _, o := range order[1:] {
+		if o.dir != order[0].dir {
+			return false
+		}
+	}
+	return true
+}
+
+// SeekAfter selects rows following the cursor in ORDER BY order,
+// it is keyset pagination which unlike OFFSET does not slow down on deep pages.
+// Columns of ORDER BY should identify a row uniquely and should not be NULL.
+func (b *selectBuilder) SeekAfter(cursor Cursor) SelectBuilder {
+	b.selectStmt.Seek = &seek{cursor: cursor}
+	return b
+}
+
+// SeekBefore selects rows preceding the cursor in ORDER BY order.
+// ORDER BY is reversed to take the closest rows with LIMIT,
+// so rows are loaded in reverse order.
+func (b *selectBuilder) SeekBefore(cursor Cursor) SelectBuilder {
+	b.selectStmt.Seek = &seek{cursor: cursor, before: true}
+	return b
+}
+
+// Cursor returns position of record for SeekAfter and SeekBefore,
+// record should be a struct with fields for all ORDER BY columns
+func (b *selectBuilder) Cursor(record interface{}) (Cursor, error) {
+	order, err := b.selectStmt.seekOrder()
+	if err != nil {
+		return nil, err
+	}
+	v, kind := extractOriginal(reflect.ValueOf(record))
+	if kind != reflect.Struct {
+		return nil, ErrInvalidPointer
+	}
+	m := structMap(v.Type())
+	cursor := make(Cursor, len(order))
+	for i, o := range order {
+		column := o.column
+		if dot := strings.LastIndexByte(column, '.'); dot != -1 {
+			column = column[dot+1:]
+		}
+		index, ok := m[column]
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		cursor[i] = v.FieldByIndex(index).Interface()
+	}
+	return cursor, nil
+}
+
+// EncodeCursor encodes cursor into an opaque url-safe token signed with key,
+// so that it can be passed to clients and later decoded by DecodeCursor
+func EncodeCursor(key []byte, cursor Cursor) (string, error) {
+	value := make([]interface{}, len(cursor))
+	for i, v := range cursor {
+		v, err := driver.DefaultParameterConverter.ConvertValue(v)
+		if err != nil {
+			return "", err
+		}
+		if v == nil {
+			return "", ErrInvalidCursor
+		}
+		value[i] = v
+	}
+
+	buf := new(bytes.Buffer)
+	err := gob.NewEncoder(buf).Encode(value)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(buf.Bytes())
+	buf.Write(mac.Sum(nil))
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecodeCursor decodes token created by EncodeCursor,
+// ErrInvalidCursor is returned if token is malformed or is signed with another key
+func DecodeCursor(key []byte, token string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) < sha256.Size {
+		return nil, ErrInvalidCursor
+	}
+	payload, sum := b[:len(b)-sha256.Size], b[len(b)-sha256.Size:]
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	if !hmac.Equal(sum, mac.Sum(nil)) {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor []interface{}
+	err = gob.NewDecoder(bytes.NewReader(payload)).Decode(&cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return cursor, nil
+}
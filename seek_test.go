@@ -0,0 +1,85 @@
+package dbr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeek(t *testing.T) {
+	sess := &Session{Connection: &Connection{Dialect: dialect.MySQL}}
+	for _, test := range []struct {
+		builder SelectBuilder
+		query   string
+		value   []interface{}
+	}{
+		{
+			builder: sess.Select("*").From("posts").Where(Eq("user_id", 1)).
+				OrderDesc("id").Limit(10).SeekAfter(Cursor{100}),
+			query: "SELECT * FROM posts WHERE (`user_id` = ?) AND (`id` < ?) ORDER BY id DESC LIMIT 10",
+			value: []interface{}{1, 100},
+		},
+		{
+			builder: sess.Select("*").From("posts").
+				OrderAsc("created_at").OrderAsc("id").Limit(10).SeekAfter(Cursor{"2020-01-01", 100}),
+			query: "SELECT * FROM posts WHERE ((`created_at`, `id`) > (?, ?)) ORDER BY created_at ASC, id ASC LIMIT 10",
+			value: []interface{}{"2020-01-01", 100},
+		},
+		{
+			builder: sess.Select("*").From("posts").
+				OrderDesc("score").OrderBy("id").Limit(10).SeekAfter(Cursor{5, 100}),
+			query: "SELECT * FROM posts WHERE ((`score` < ?) OR ((`score` = ?) AND (`id` > ?))) ORDER BY score DESC, id LIMIT 10",
+			value: []interface{}{5, 5, 100},
+		},
+		{
+			builder: sess.Select("*").From("posts").
+				OrderDesc("score").OrderBy("p.id asc").Limit(10).SeekBefore(Cursor{5, 100}),
+			query: "SELECT * FROM posts WHERE ((`score` > ?) OR ((`score` = ?) AND (`p`.`id` < ?))) ORDER BY score ASC, p.id DESC LIMIT 10",
+			value: []interface{}{5, 5, 100},
+		},
+	} {
+		buf := NewBuffer()
+		err := test.builder.Build(dialect.MySQL, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, buf.String())
+		assert.Equal(t, test.value, buf.Value())
+	}
+
+	err := sess.Select("*").From("posts").OrderBy("RAND()").SeekAfter(Cursor{1}).Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrInvalidSeekOrder, err)
+	err = sess.Select("*").From("posts").OrderAsc("id").SeekAfter(Cursor{1, 2}).Build(dialect.MySQL, NewBuffer())
+	assert.Equal(t, ErrInvalidCursor, err)
+}
+
+func TestCursor(t *testing.T) {
+	type post struct {
+		ID        int64
+		CreatedAt time.Time
+		Title     string
+	}
+	record := &post{ID: 100, CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Title: "a"}
+
+	sess := &Session{Connection: &Connection{Dialect: dialect.MySQL}}
+	builder := sess.Select("*").From("posts p").OrderDesc("p.created_at").OrderDesc("id")
+	cursor, err := builder.Cursor(record)
+	assert.NoError(t, err)
+	assert.Equal(t, Cursor{record.CreatedAt, record.ID}, cursor)
+
+	key := []byte("secret")
+	token, err := EncodeCursor(key, cursor)
+	assert.NoError(t, err)
+
+	decoded, err := DecodeCursor(key, token)
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = DecodeCursor([]byte("another"), token)
+	assert.Equal(t, ErrInvalidCursor, err)
+	_, err = DecodeCursor(key, token[:len(token)-1]+"A")
+	assert.Equal(t, ErrInvalidCursor, err)
+
+	_, err = sess.Select("*").From("posts").OrderAsc("missing").Cursor(record)
+	assert.Equal(t, ErrInvalidCursor, err)
+}
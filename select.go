@@ -5,11 +5,17 @@ type SelectStmt interface {
 	Builder
 
 	From(table interface{}) SelectStmt
+	Columns(column ...interface{}) SelectStmt
 	Distinct() SelectStmt
 	Prewhere(query interface{}, value ...interface{}) SelectStmt
 	Where(query interface{}, value ...interface{}) SelectStmt
 	Having(query interface{}, value ...interface{}) SelectStmt
 	GroupBy(col ...string) SelectStmt
+	GroupByExpr(expr ...Builder) SelectStmt
+	GroupByRollup(col ...interface{}) SelectStmt
+	GroupByCube(col ...interface{}) SelectStmt
+	GroupingSets(set ...[]interface{}) SelectStmt
+	WithTotals() SelectStmt
 	OrderAsc(col string) SelectStmt
 	OrderDesc(col string) SelectStmt
 	Limit(n uint64) SelectStmt
@@ -21,6 +27,9 @@ type SelectStmt interface {
 	RightJoin(table, on interface{}) SelectStmt
 	FullJoin(table, on interface{}) SelectStmt
 	AddComment(text string) SelectStmt
+	With(name string, builder Builder) SelectStmt
+	WithRecursive(name string, builder Builder) SelectStmt
+	Window(name string, spec WindowBuilder) SelectStmt
 	As(alias string) Builder
 }
 
@@ -29,6 +38,7 @@ type selectStmt struct {
 
 	IsDistinct bool
 
+	CTE       []*cte
 	Column    []interface{}
 	Table     interface{}
 	JoinTable []Builder
@@ -37,8 +47,11 @@ type selectStmt struct {
 	PrewhereCond []Builder
 	WhereCond    []Builder
 	Group        []Builder
+	IsWithTotals bool
 	HavingCond   []Builder
+	WindowDef    []*windowDef
 	Order        []Builder
+	Seek         *seek
 
 	LimitCount   int64
 	OffsetCount  int64
@@ -67,6 +80,11 @@ func (b *selectStmt) Build(d Dialect, buf Buffer) error {
 		}
 	}
 
+	err := buildWith(d, buf, b.CTE)
+	if err != nil {
+		return err
+	}
+
 	buf.WriteString("SELECT ")
 
 	if b.IsDistinct {
@@ -120,15 +138,29 @@ func (b *selectStmt) Build(d Dialect, buf Buffer) error {
 		}
 	}
 
-	if len(b.WhereCond) > 0 {
+	where, orders := b.WhereCond, b.Order
+	if b.Seek != nil {
+		cond, seekOrder, err := b.buildSeek()
+		if err != nil {
+			return err
+		}
+		where = append(where[:len(where):len(where)], cond)
+		orders = seekOrder
+	}
+
+	if len(where) > 0 {
 		buf.WriteString(" WHERE ")
-		err := And(b.WhereCond...).Build(d, buf)
+		err := And(where...).Build(d, buf)
 		if err != nil {
 			return err
 		}
 	}
 
 	if len(b.Group) > 0 {
+		err := checkGroup(d, b.Group)
+		if err != nil {
+			return err
+		}
 		buf.WriteString(" GROUP BY ")
 		for i, group := range b.Group {
 			if i > 0 {
@@ -141,6 +173,15 @@ func (b *selectStmt) Build(d Dialect, buf Buffer) error {
 		}
 	}
 
+	if b.IsWithTotals {
+		keyword := d.WithTotals()
+		if len(keyword) == 0 {
+			return ErrNotSupported
+		}
+		buf.WriteString(" ")
+		buf.WriteString(keyword)
+	}
+
 	if len(b.HavingCond) > 0 {
 		buf.WriteString(" HAVING ")
 		err := And(b.HavingCond...).Build(d, buf)
@@ -149,9 +190,14 @@ func (b *selectStmt) Build(d Dialect, buf Buffer) error {
 		}
 	}
 
-	if len(b.Order) > 0 {
+	err = buildWindow(d, buf, b.WindowDef)
+	if err != nil {
+		return err
+	}
+
+	if len(orders) > 0 {
 		buf.WriteString(" ORDER BY ")
-		for i, order := range b.Order {
+		for i, order := range orders {
 			if i > 0 {
 				buf.WriteString(", ")
 			}
@@ -213,6 +259,12 @@ func createSelectStmtBySQL(query string, value []interface{}) *selectStmt {
 	}
 }
 
+// Columns adds columns to select, a column is a string or a Builder like window function call
+func (b *selectStmt) Columns(column ...interface{}) SelectStmt {
+	b.Column = append(b.Column, column...)
+	return b
+}
+
 // Distinct adds `DISTINCT`
 func (b *selectStmt) Distinct() SelectStmt {
 	b.IsDistinct = true
@@ -262,6 +314,37 @@ func (b *selectStmt) GroupBy(col ...string) SelectStmt {
 	return b
 }
 
+// GroupByExpr specifies expressions for grouping
+func (b *selectStmt) GroupByExpr(expr ...Builder) SelectStmt {
+	b.Group = append(b.Group, expr...)
+	return b
+}
+
+// GroupByRollup adds `ROLLUP` grouping of columns, which can be strings or Builders.
+// MySQL and ClickHouse use `WITH ROLLUP` modifier, so it can't be combined with other grouping there.
+func (b *selectStmt) GroupByRollup(col ...interface{}) SelectStmt {
+	b.Group = append(b.Group, newGrouping(rollup, col))
+	return b
+}
+
+// GroupByCube adds `CUBE` grouping of columns, which can be strings or Builders
+func (b *selectStmt) GroupByCube(col ...interface{}) SelectStmt {
+	b.Group = append(b.Group, newGrouping(cube, col))
+	return b
+}
+
+// GroupingSets adds `GROUPING SETS`, columns of sets can be strings or Builders
+func (b *selectStmt) GroupingSets(set ...[]interface{}) SelectStmt {
+	b.Group = append(b.Group, newGrouping(groupingSets, set...))
+	return b
+}
+
+// WithTotals adds ClickHouse `WITH TOTALS` modifier of GROUP BY
+func (b *selectStmt) WithTotals() SelectStmt {
+	b.IsWithTotals = true
+	return b
+}
+
 // OrderAsc specifies columns for ordering in asc direction
 func (b *selectStmt) OrderAsc(col string) SelectStmt {
 	b.Order = append(b.Order, order(col, asc))
@@ -328,6 +411,24 @@ func (b *selectStmt) AddComment(comment string) SelectStmt {
 	return b
 }
 
+// With adds a common table expression `WITH name AS (...)`
+func (b *selectStmt) With(name string, builder Builder) SelectStmt {
+	b.CTE = append(b.CTE, &cte{name: name, builder: builder})
+	return b
+}
+
+// WithRecursive adds a recursive common table expression `WITH RECURSIVE name AS (...)`
+func (b *selectStmt) WithRecursive(name string, builder Builder) SelectStmt {
+	b.CTE = append(b.CTE, &cte{name: name, builder: builder, recursive: true})
+	return b
+}
+
+// Window defines named window `WINDOW name AS (...)`, spec is created by NewWindow
+func (b *selectStmt) Window(name string, spec WindowBuilder) SelectStmt {
+	b.WindowDef = append(b.WindowDef, &windowDef{name: name, spec: spec})
+	return b
+}
+
 // As creates alias for select statement
 func (b *selectStmt) As(alias string) Builder {
 	return as(b, alias)
@@ -16,11 +16,19 @@ type SelectBuilder interface {
 
 	As(alias string) Builder
 	Comment(text string) SelectBuilder
+	Count() (int64, error)
+	CountContext(ctx context.Context) (int64, error)
 	Distinct() SelectBuilder
 	ForUpdate() SelectBuilder
 	From(table interface{}) SelectBuilder
+	Columns(column ...interface{}) SelectBuilder
 	FullJoin(table, on interface{}) SelectBuilder
 	GroupBy(col ...string) SelectBuilder
+	GroupByExpr(expr ...Builder) SelectBuilder
+	GroupByRollup(col ...interface{}) SelectBuilder
+	GroupByCube(col ...interface{}) SelectBuilder
+	GroupingSets(set ...[]interface{}) SelectBuilder
+	WithTotals() SelectBuilder
 	Having(query interface{}, value ...interface{}) SelectBuilder
 	InTimezone(loc *time.Location) SelectBuilder
 	Join(table, on interface{}) SelectBuilder
@@ -34,10 +42,18 @@ type SelectBuilder interface {
 	Paginate(page, perPage uint64) SelectBuilder
 	Prewhere(query interface{}, value ...interface{}) SelectBuilder
 	RightJoin(table, on interface{}) SelectBuilder
+	SeekAfter(cursor Cursor) SelectBuilder
+	SeekBefore(cursor Cursor) SelectBuilder
+	Cursor(record interface{}) (Cursor, error)
 	SkipLocked() SelectBuilder
 	Where(query interface{}, value ...interface{}) SelectBuilder
+	With(name string, builder Builder) SelectBuilder
+	WithRecursive(name string, builder Builder) SelectBuilder
+	Window(name string, spec WindowBuilder) SelectBuilder
 	GetRows() (*sql.Rows, error)
 	GetRowsContext(context.Context) (*sql.Rows, error)
+	Iterate() (Iterator, error)
+	IterateContext(ctx context.Context) (Iterator, error)
 }
 
 type selectBuilder struct {
@@ -48,6 +64,8 @@ type selectBuilder struct {
 	selectStmt *selectStmt
 	timezone   *time.Location
 	ctx        context.Context
+	// sess is the session of the builder, locking reads are run on its primary
+	sess *Session
 }
 
 func prepareSelect(a []string) []interface{} {
@@ -58,14 +76,16 @@ func prepareSelect(a []string) []interface{} {
 	return b
 }
 
-// Select creates a SelectBuilder
+// Select creates a SelectBuilder, for Cluster sessions it is run on a replica
 func (sess *Session) Select(column ...string) SelectBuilder {
+	runner, log := sess.reader()
 	return &selectBuilder{
-		runner:        sess,
-		EventReceiver: sess.EventReceiver,
+		runner:        runner,
+		EventReceiver: log,
 		Dialect:       sess.Dialect,
 		selectStmt:    createSelectStmt(prepareSelect(column)),
 		ctx:           sess.ctx,
+		sess:          sess,
 	}
 }
 
@@ -80,14 +100,16 @@ func (tx *Tx) Select(column ...string) SelectBuilder {
 	}
 }
 
-// SelectBySql creates a SelectBuilder from raw query
+// SelectBySql creates a SelectBuilder from raw query, for Cluster sessions it is run on a replica
 func (sess *Session) SelectBySql(query string, value ...interface{}) SelectBuilder {
+	runner, log := sess.reader()
 	return &selectBuilder{
-		runner:        sess,
-		EventReceiver: sess.EventReceiver,
+		runner:        runner,
+		EventReceiver: log,
 		Dialect:       sess.Dialect,
 		selectStmt:    createSelectStmtBySQL(query, value),
 		ctx:           sess.ctx,
+		sess:          sess,
 	}
 }
 
@@ -103,25 +125,30 @@ func (tx *Tx) SelectBySql(query string, value ...interface{}) SelectBuilder {
 }
 
 func (b *selectBuilder) changeTimezone(value reflect.Value) {
+	inTimezone(b.timezone, value)
+}
+
+// inTimezone sets location of all time.Time values found in value
+func inTimezone(loc *time.Location, value reflect.Value) {
 	v, t := extractOriginal(value)
 	switch t {
 	case reflect.Slice, reflect.Array:
 		for i := 0; i < v.Len(); i++ {
-			b.changeTimezone(v.Index(i))
+			inTimezone(loc, v.Index(i))
 		}
 	case reflect.Map:
 		// TODO: add timezone changing for map keys
 		for _, k := range v.MapKeys() {
-			b.changeTimezone(v.MapIndex(k))
+			inTimezone(loc, v.MapIndex(k))
 		}
 	case reflect.Struct:
 		if v.Type() == reflect.TypeOf(time.Time{}) {
-			v.Set(reflect.ValueOf(v.Interface().(time.Time).In(b.timezone)))
+			v.Set(reflect.ValueOf(v.Interface().(time.Time).In(loc)))
 			return
 		}
 
 		for i := 0; i < v.NumField(); i++ {
-			b.changeTimezone(v.Field(i))
+			inTimezone(loc, v.Field(i))
 		}
 	}
 }
@@ -190,6 +217,24 @@ func (b *selectBuilder) GetRowsContext(ctx context.Context) (*sql.Rows, error) {
 	return rows, err
 }
 
+// Iterate returns Iterator over query result with background context
+func (b *selectBuilder) Iterate() (Iterator, error) {
+	return b.IterateContext(b.ctx)
+}
+
+// IterateContext returns Iterator over query result.
+// Timing and tracing events are emitted when the iteration finishes.
+func (b *selectBuilder) IterateContext(ctx context.Context) (Iterator, error) {
+	it, err := iterate(ctx, b.runner, b.EventReceiver, b, b.Dialect)
+	if err != nil {
+		return nil, err
+	}
+	if b.timezone != nil {
+		it.afterScan = b.changeTimezone
+	}
+	return it, nil
+}
+
 // LoadValue loads any value from query result with background context, returns ErrNotFound if there is no result
 func (b *selectBuilder) LoadValue(value interface{}) error {
 	return b.LoadValueContext(b.ctx, value)
@@ -248,6 +293,13 @@ func (b *selectBuilder) FullJoin(table, on interface{}) SelectBuilder {
 	return b
 }
 
+// Columns adds columns to select, it is used for columns like window function calls,
+// which can't be passed to Session.Select and Tx.Select
+func (b *selectBuilder) Columns(column ...interface{}) SelectBuilder {
+	b.selectStmt.Columns(column...)
+	return b
+}
+
 // Distinct adds `DISTINCT`
 func (b *selectBuilder) Distinct() SelectBuilder {
 	b.selectStmt.Distinct()
@@ -266,6 +318,37 @@ func (b *selectBuilder) GroupBy(col ...string) SelectBuilder {
 	return b
 }
 
+// GroupByExpr specifies expressions for grouping
+func (b *selectBuilder) GroupByExpr(expr ...Builder) SelectBuilder {
+	b.selectStmt.GroupByExpr(expr...)
+	return b
+}
+
+// GroupByRollup adds `ROLLUP` grouping of columns, which can be strings or Builders.
+// MySQL and ClickHouse apply `WITH ROLLUP` to all grouping columns.
+func (b *selectBuilder) GroupByRollup(col ...interface{}) SelectBuilder {
+	b.selectStmt.GroupByRollup(col...)
+	return b
+}
+
+// GroupByCube adds `CUBE` grouping of columns, which can be strings or Builders
+func (b *selectBuilder) GroupByCube(col ...interface{}) SelectBuilder {
+	b.selectStmt.GroupByCube(col...)
+	return b
+}
+
+// GroupingSets adds `GROUPING SETS`, columns of sets can be strings or Builders
+func (b *selectBuilder) GroupingSets(set ...[]interface{}) SelectBuilder {
+	b.selectStmt.GroupingSets(set...)
+	return b
+}
+
+// WithTotals adds ClickHouse `WITH TOTALS` modifier of GROUP BY
+func (b *selectBuilder) WithTotals() SelectBuilder {
+	b.selectStmt.WithTotals()
+	return b
+}
+
 // Having adds a having condition
 func (b *selectBuilder) Having(query interface{}, value ...interface{}) SelectBuilder {
 	b.selectStmt.Having(query, value...)
@@ -322,12 +405,14 @@ func (b *selectBuilder) Where(query interface{}, value ...interface{}) SelectBui
 // ForUpdate adds lock via FOR UPDATE
 func (b *selectBuilder) ForUpdate() SelectBuilder {
 	b.selectStmt.ForUpdate()
+	b.usePrimary()
 	return b
 }
 
 // SkipLocked skips locked rows via SKIP LOCKED
 func (b *selectBuilder) SkipLocked() SelectBuilder {
 	b.selectStmt.SkipLocked()
+	b.usePrimary()
 	return b
 }
 
@@ -357,3 +442,21 @@ func (b *selectBuilder) Comment(text string) SelectBuilder {
 	b.selectStmt.AddComment(text)
 	return b
 }
+
+// With adds a common table expression `WITH name AS (...)`
+func (b *selectBuilder) With(name string, builder Builder) SelectBuilder {
+	b.selectStmt.With(name, builder)
+	return b
+}
+
+// WithRecursive adds a recursive common table expression `WITH RECURSIVE name AS (...)`
+func (b *selectBuilder) WithRecursive(name string, builder Builder) SelectBuilder {
+	b.selectStmt.WithRecursive(name, builder)
+	return b
+}
+
+// Window defines named window `WINDOW name AS (...)`, spec is created by NewWindow
+func (b *selectBuilder) Window(name string, spec WindowBuilder) SelectBuilder {
+	b.selectStmt.Window(name, spec)
+	return b
+}
@@ -34,6 +34,27 @@ func TestSelectStmt(t *testing.T) {
 	assert.EqualError(t, err, ErrPrewhereNotSupported.Error()) // handle PREWHERE statement error
 }
 
+func TestSelectStmtWith(t *testing.T) {
+	buf := NewBuffer()
+	builder := Select("*").
+		With("active", Select("id").From("users").Where(Eq("active", true))).
+		WithRecursive("tree", UnionAll(
+			Select("id", "parent_id").From("nodes").Where(Eq("id", 1)),
+			Select("nodes.id", "nodes.parent_id").From("nodes").Join("tree", "nodes.parent_id = tree.id"),
+		)).
+		From("tree").
+		Where("id IN ?", Select("id").From("active"))
+
+	err := builder.Build(dialect.PostgreSQL, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, `WITH RECURSIVE "active" AS (SELECT id FROM users WHERE ("active" = ?)), "tree" AS (? UNION ALL ?) SELECT * FROM tree WHERE (id IN ?)`, buf.String())
+	assert.Equal(t, 4, len(buf.Value()))
+
+	query, err := InterpolateForDialect(buf.String(), buf.Value(), dialect.PostgreSQL)
+	assert.NoError(t, err)
+	assert.Equal(t, `WITH RECURSIVE "active" AS (SELECT id FROM users WHERE ("active" = TRUE)), "tree" AS ((SELECT id, parent_id FROM nodes WHERE ("id" = 1)) UNION ALL (SELECT nodes.id, nodes.parent_id FROM nodes JOIN "tree" ON nodes.parent_id = tree.id)) SELECT * FROM tree WHERE (id IN (SELECT id FROM active))`, query)
+}
+
 func BenchmarkSelectSQL(b *testing.B) {
 	buf := NewBuffer()
 	for i := 0; i < b.N; i++ {
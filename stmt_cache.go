@@ -0,0 +1,188 @@
+package dbr
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// DefaultStmtCacheSize is the number of prepared statements cached per Connection
+// in BindParams mode unless Connection.StmtCacheSize is set
+const DefaultStmtCacheSize = 100
+
+// ParamMode defines how values are passed to the database
+type ParamMode int
+
+const (
+	// InterpolateParams interpolates values into the query on the client side
+	InterpolateParams ParamMode = iota
+	// BindParams sends values separately using server-side prepared statements
+	BindParams
+)
+
+type preparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// stmtCache is a LRU cache of prepared statements
+type stmtCache struct {
+	mu    sync.Mutex
+	list  *list.List // *cachedStmt, most recently used first
+	items map[string]*list.Element
+}
+
+type cachedStmt struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+// prepare returns prepared statement for query,
+// release must be called once the statement is not used anymore
+func (c *stmtCache) prepare(ctx context.Context, db preparer, query string, size int) (*sql.Stmt, func(), error) {
+	if size <= 0 {
+		size = DefaultStmtCacheSize
+	}
+
+	c.mu.Lock()
+	if el, ok := c.items[query]; ok {
+		c.list.MoveToFront(el)
+		entry := el.Value.(*cachedStmt)
+		entry.refs++
+		c.mu.Unlock()
+		return entry.stmt, func() { c.release(entry) }, nil
+	}
+	c.mu.Unlock()
+
+	stmt, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.items[query]; ok {
+		// prepared concurrently by someone else
+		return stmt, func() { stmt.Close() }, nil
+	}
+	if c.items == nil {
+		c.list = list.New()
+		c.items = make(map[string]*list.Element)
+	}
+	entry := &cachedStmt{query: query, stmt: stmt, refs: 1}
+	c.items[query] = c.list.PushFront(entry)
+	for c.list.Len() > size {
+		el := c.list.Back()
+		c.list.Remove(el)
+		evicted := el.Value.(*cachedStmt)
+		delete(c.items, evicted.query)
+		evicted.evicted = true
+		if evicted.refs == 0 {
+			evicted.stmt.Close()
+		}
+	}
+	return stmt, func() { c.release(entry) }, nil
+}
+
+func (c *stmtCache) release(entry *cachedStmt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.refs--
+	if entry.evicted && entry.refs == 0 {
+		entry.stmt.Close()
+	}
+}
+
+func (c *stmtCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// close closes all cached statements
+func (c *stmtCache) close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var err error
+	for _, el := range c.items {
+		entry := el.Value.(*cachedStmt)
+		entry.evicted = true
+		if entry.refs == 0 {
+			if e := entry.stmt.Close(); err == nil {
+				err = e
+			}
+		}
+	}
+	c.list, c.items = nil, nil
+	return err
+}
+
+// execStmt runs ExecContext using prepared statement in BindParams mode
+func execStmt(ctx context.Context, runner runner, query string, value []interface{}) (sql.Result, error) {
+	if bindParams(runner) {
+		stmt, release, err := runner.(stmtRunner).prepareStmt(ctx, query)
+		if err == nil {
+			defer release()
+			return stmt.ExecContext(ctx, value...)
+		}
+		if err != ErrNotSupported {
+			return nil, err
+		}
+	}
+	return runner.ExecContext(ctx, query, value...)
+}
+
+// queryStmt runs QueryContext using prepared statement in BindParams mode
+func queryStmt(ctx context.Context, runner runner, query string, value []interface{}) (*sql.Rows, error) {
+	if bindParams(runner) {
+		stmt, release, err := runner.(stmtRunner).prepareStmt(ctx, query)
+		if err == nil {
+			// statement is closed once rows are closed
+			defer release()
+			return stmt.QueryContext(ctx, value...)
+		}
+		if err != ErrNotSupported {
+			return nil, err
+		}
+	}
+	return runner.QueryContext(ctx, query, value...)
+}
+
+// bindParams reports whether runner is in BindParams mode
+func bindParams(runner runner) bool {
+	r, ok := runner.(stmtRunner)
+	return ok && r.paramMode() == BindParams
+}
+
+// stmtRunner is implemented by runners which run queries with bound parameters
+// using prepared statements
+type stmtRunner interface {
+	paramMode() ParamMode
+	prepareStmt(ctx context.Context, query string) (*sql.Stmt, func(), error)
+}
+
+func (sess *Session) paramMode() ParamMode {
+	return sess.ParamMode
+}
+
+func (sess *Session) prepareStmt(ctx context.Context, query string) (*sql.Stmt, func(), error) {
+	db, ok := sess.DBConn.(preparer)
+	if !ok {
+		return nil, nil, ErrNotSupported
+	}
+	return sess.stmts.prepare(ctx, db, query, sess.StmtCacheSize)
+}
+
+func (tx *Tx) paramMode() ParamMode {
+	return tx.ParamMode
+}
+
+// prepareStmt prepares statement on the transaction, statements are cached until it is finished
+func (tx *Tx) prepareStmt(ctx context.Context, query string) (*sql.Stmt, func(), error) {
+	if tx.stmts == nil {
+		return nil, nil, ErrNotSupported
+	}
+	return tx.stmts.prepare(ctx, tx.Tx, query, tx.stmtCacheSize)
+}
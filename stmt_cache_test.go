@@ -0,0 +1,113 @@
+package dbr
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpolateBindParams(t *testing.T) {
+	i := interpolator{
+		Buffer:     NewBuffer(),
+		Dialect:    dialect.PostgreSQL,
+		BindParams: true,
+	}
+	err := i.interpolate("? ? ? ? ? ?", []interface{}{
+		1,
+		[]int{2, 3},
+		map[string]bool{"b": true, "a": true},
+		[]byte{4},
+		NullString{},
+		Select("id").From("t").Where(Eq("id", 5)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `$1 ($2,$3) ($4,$5) $6 $7 (SELECT id FROM t WHERE ("id" = $8))`, i.String())
+	assert.Equal(t, []interface{}{1, 2, 3, "a", "b", []byte{4}, NullString{}, 5}, i.Value())
+}
+
+func TestBindParamsStmtCache(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: nullReceiver, ParamMode: BindParams}
+	sess := conn.NewSession(nil)
+
+	update := regexp.QuoteMeta(`UPDATE "people" SET "name" = $1 WHERE ("id" IN ($2,$3))`)
+	mock.ExpectPrepare(update)
+	mock.ExpectExec(update).WithArgs("a", 1, 2).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(update).WithArgs("b", 3, 4).WillReturnResult(sqlmock.NewResult(0, 2))
+
+	for _, v := range []struct {
+		name string
+		id   []int
+	}{{"a", []int{1, 2}}, {"b", []int{3, 4}}} {
+		_, err = sess.Update("people").Set("name", v.name).Where(Eq("id", v.id)).Exec()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, conn.stmts.len())
+
+	sess.ParamMode = InterpolateParams
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM people WHERE ("id" = 1)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	id, err := sess.Select("id").From("people").Where(Eq("id", 1)).ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, id)
+	assert.Equal(t, 1, conn.stmts.len())
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBindParamsStmtCacheEviction(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: nullReceiver, StmtCacheSize: 1}
+	sess := conn.NewSession(nil)
+	sess.ParamMode = BindParams
+
+	first := regexp.QuoteMeta(`SELECT id FROM people WHERE ("id" = $1)`)
+	second := regexp.QuoteMeta(`SELECT id FROM people WHERE ("name" = $1)`)
+	mock.ExpectPrepare(first).WillBeClosed()
+	mock.ExpectQuery(first).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectPrepare(second)
+	mock.ExpectQuery(second).WithArgs("a").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	id, err := sess.Select("id").From("people").Where(Eq("id", 1)).ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, id)
+	id, err = sess.Select("id").From("people").Where(Eq("name", "a")).ReturnInt64()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, id)
+	assert.Equal(t, 1, conn.stmts.len())
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBindParamsTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: nullReceiver, ParamMode: BindParams}
+
+	insert := regexp.QuoteMeta(`INSERT INTO "people" ("name") VALUES ($1)`)
+	mock.ExpectBegin()
+	// prepared once on the transaction and closed when it is committed
+	prepare := mock.ExpectPrepare(insert)
+	prepare.ExpectExec().WithArgs("a").WillReturnResult(sqlmock.NewResult(1, 1))
+	prepare.ExpectExec().WithArgs("b").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+	prepare.WillBeClosed()
+
+	tx, err := conn.NewSession(nil).Begin()
+	assert.NoError(t, err)
+	for _, name := range []string{"a", "b"} {
+		_, err = tx.InsertInto("people").Columns("name").Values(name).Exec()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, tx.stmts.len())
+	assert.NoError(t, tx.Commit())
+	assert.Equal(t, 0, tx.stmts.len())
+	assert.Equal(t, 0, conn.stmts.len())
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,9 @@ package dbr
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
 )
 
 // Tx is a transaction for the given Session
@@ -11,6 +14,23 @@ type Tx struct {
 	Dialect Dialect
 	*sql.Tx
 	ctx context.Context
+
+	// ParamMode is inherited from the session
+	ParamMode ParamMode
+	// stmts are prepared statements of the transaction, they are closed when it is finished
+	stmts         *stmtCache
+	stmtCacheSize int
+
+	// savepoint is set for nested transactions created by Tx.Begin
+	savepoint string
+	parent    *Tx
+	// lastSavepoint numbers savepoints of nested transactions, it is used by the outermost transaction
+	lastSavepoint int
+	// isDone is set when the transaction is committed or rolled back
+	isDone bool
+
+	onCommit   []func()
+	onRollback []func()
 }
 
 // Begin creates a transaction for the given session
@@ -20,7 +40,11 @@ func (sess *Session) Begin() (*Tx, error) {
 
 // BeginWithOpts creates a transaction for the given section with ability to set TxOpts
 func (sess *Session) BeginWithOpts(opts *sql.TxOptions) (*Tx, error) {
-	tx, err := sess.beginTx(opts)
+	return sess.beginWithContext(sess.ctx, opts)
+}
+
+func (sess *Session) beginWithContext(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	tx, err := sess.beginTx(ctx, opts)
 	if err != nil {
 		return nil, sess.EventErr("dbr.begin.error", err)
 	}
@@ -30,23 +54,163 @@ func (sess *Session) BeginWithOpts(opts *sql.TxOptions) (*Tx, error) {
 		EventReceiver: sess.EventReceiver,
 		Dialect:       sess.Dialect,
 		Tx:            tx,
-		ctx:           sess.ctx,
+		ctx:           ctx,
+		ParamMode:     sess.ParamMode,
+		stmts:         &stmtCache{},
+		stmtCacheSize: sess.StmtCacheSize,
+	}, nil
+}
+
+// RunInTx runs fn in a transaction, which is committed if fn returns nil
+// and is rolled back if fn returns an error or panics.
+// The transaction is run again if it fails with an error accepted by RetryPolicy of the connection.
+func (sess *Session) RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(tx *Tx) error) error {
+	policy := sess.RetryPolicy
+	if policy == nil {
+		policy = &DefaultRetryPolicy
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := sess.runInTx(ctx, opts, fn)
+		if err == nil {
+			return nil
+		}
+		if attempt >= policy.MaxAttempts || policy.Retryable == nil || !policy.Retryable(sess.Dialect, err) {
+			return err
+		}
+		sess.EventErrKv("dbr.tx.retry", err, kvs{
+			"attempt": strconv.Itoa(attempt),
+		})
+
+		var delay time.Duration
+		if policy.Backoff != nil {
+			delay = policy.Backoff(attempt)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (sess *Session) runInTx(ctx context.Context, opts *sql.TxOptions, fn func(tx *Tx) error) error {
+	tx, err := sess.beginWithContext(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	err = fn(tx)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Begin creates a nested transaction, which is a savepoint inside the transaction.
+// Commit of the nested transaction releases the savepoint and Rollback rolls back to it.
+func (tx *Tx) Begin() (*Tx, error) {
+	root := tx
+	for root.parent != nil {
+		root = root.parent
+	}
+	root.lastSavepoint++
+	name := fmt.Sprintf("dbr_savepoint_%d", root.lastSavepoint)
+	err := tx.Savepoint(name)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{
+		EventReceiver: tx.EventReceiver,
+		Dialect:       tx.Dialect,
+		Tx:            tx.Tx,
+		ctx:           tx.ctx,
+		ParamMode:     tx.ParamMode,
+		stmts:         tx.stmts,
+		stmtCacheSize: tx.stmtCacheSize,
+		savepoint:     name,
+		parent:        tx,
 	}, nil
 }
 
+// Savepoint creates a savepoint with the given name
+func (tx *Tx) Savepoint(name string) error {
+	return tx.execSavepoint("dbr.savepoint", tx.Dialect.Savepoint(name))
+}
+
+// RollbackTo rolls back the transaction to the savepoint with the given name
+func (tx *Tx) RollbackTo(name string) error {
+	return tx.execSavepoint("dbr.rollback_to", tx.Dialect.RollbackToSavepoint(name))
+}
+
+// Release destroys the savepoint with the given name keeping its changes
+func (tx *Tx) Release(name string) error {
+	return tx.execSavepoint("dbr.release", tx.Dialect.ReleaseSavepoint(name))
+}
+
+func (tx *Tx) execSavepoint(eventName, query string) error {
+	if query == "" {
+		return tx.EventErr(eventName+".error", ErrNotSupported)
+	}
+	_, err := tx.Tx.ExecContext(tx.ctx, query)
+	if err != nil {
+		return tx.EventErr(eventName+".error", err)
+	}
+	tx.Event(eventName)
+	return nil
+}
+
 // Commit finishes the transaction
 func (tx *Tx) Commit() error {
+	if tx.savepoint != "" {
+		if tx.isDone {
+			return sql.ErrTxDone
+		}
+		err := tx.Release(tx.savepoint)
+		if err != nil {
+			return err
+		}
+		tx.isDone = true
+		// hooks of the nested transaction are run when the parent finishes
+		tx.parent.onCommit = append(tx.parent.onCommit, tx.onCommit...)
+		tx.parent.onRollback = append(tx.parent.onRollback, tx.onRollback...)
+		tx.onCommit, tx.onRollback = nil, nil
+		return nil
+	}
+
 	err := tx.Tx.Commit()
 	if err != nil {
+		tx.finish(false)
 		return tx.EventErr("dbr.commit.error", err)
 	}
 	tx.Event("dbr.commit")
+	tx.finish(true)
 	return nil
 }
 
 // Rollback cancels the transaction
 func (tx *Tx) Rollback() error {
+	if tx.savepoint != "" {
+		if tx.isDone {
+			return sql.ErrTxDone
+		}
+		err := tx.RollbackTo(tx.savepoint)
+		if err != nil {
+			return err
+		}
+		tx.isDone = true
+		tx.finish(false)
+		return nil
+	}
+
 	err := tx.Tx.Rollback()
+	// database/sql rolls back the transaction itself when its context is canceled
+	if err != sql.ErrTxDone || !tx.isDone {
+		tx.finish(false)
+	}
 	if err != nil {
 		return tx.EventErr("dbr.rollback", err)
 	}
@@ -58,12 +222,61 @@ func (tx *Tx) Rollback() error {
 // Useful to defer tx.RollbackUnlessCommitted() -- so you don't have to handle N failure cases
 // Keep in mind the only way to detect an error on the rollback is via the event log.
 func (tx *Tx) RollbackUnlessCommitted() {
+	if tx.savepoint != "" {
+		if !tx.isDone {
+			// error is already reported by RollbackTo
+			tx.Rollback()
+		}
+		return
+	}
+
 	err := tx.Tx.Rollback()
 	if err == sql.ErrTxDone {
-		// ok
+		// rolled back by database/sql if the context is canceled
+		if !tx.isDone {
+			tx.finish(false)
+		}
 	} else if err != nil {
 		tx.EventErr("dbr.rollback_unless_committed", err)
+		tx.finish(false)
 	} else {
 		tx.Event("dbr.rollback")
+		tx.finish(false)
 	}
 }
+
+// OnCommit registers fn to be run after the transaction is committed.
+// Hooks of a nested transaction are run after its outermost transaction is committed.
+func (tx *Tx) OnCommit(fn func()) {
+	tx.onCommit = append(tx.onCommit, fn)
+}
+
+// OnRollback registers fn to be run after the transaction is rolled back
+func (tx *Tx) OnRollback(fn func()) {
+	tx.onRollback = append(tx.onRollback, fn)
+}
+
+// finish runs registered hooks in registration order, panics of hooks are reported via EventErr
+func (tx *Tx) finish(committed bool) {
+	tx.isDone = true
+	if tx.parent == nil && tx.stmts != nil {
+		tx.stmts.close()
+	}
+	hooks := tx.onRollback
+	if committed {
+		hooks = tx.onCommit
+	}
+	tx.onCommit, tx.onRollback = nil, nil
+	for _, fn := range hooks {
+		tx.runHook(fn)
+	}
+}
+
+func (tx *Tx) runHook(fn func()) {
+	defer func() {
+		if p := recover(); p != nil {
+			tx.EventErr("dbr.tx.hook.panic", fmt.Errorf("dbr: transaction hook panic: %v", p))
+		}
+	}()
+	fn()
+}
@@ -1,10 +1,15 @@
 package dbr
 
 import (
-	"github.com/mailru/dbr/dialect"
-
+	"context"
+	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/mailru/dbr/dialect"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -61,3 +66,241 @@ func TestTransactionRollback(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestRunInTx(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	recv := &testEventReceiver{}
+	conn := &Connection{
+		DBConn:        db,
+		Dialect:       dialect.MySQL,
+		EventReceiver: recv,
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts: 3,
+			Retryable:   IsRetryableError,
+		},
+	}
+	sess := conn.NewSession(nil)
+
+	deadlock := &mysql.MySQLError{Number: 1213, Message: "Deadlock found"}
+	dbmock.ExpectBegin()
+	dbmock.ExpectExec("UPDATE `dbr_people`").WillReturnError(deadlock)
+	dbmock.ExpectRollback()
+	dbmock.ExpectBegin()
+	dbmock.ExpectExec("UPDATE `dbr_people`").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbmock.ExpectCommit()
+
+	attempts := 0
+	err = sess.RunInTx(context.Background(), nil, func(tx *Tx) error {
+		attempts++
+		_, err := tx.Update("dbr_people").Set("name", "jonathan").Where(Eq("id", 1)).Exec()
+		return err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Contains(t, recv.events, "dbr.tx.retry")
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestRunInTxError(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.MySQL, EventReceiver: nullReceiver}
+	sess := conn.NewSession(nil)
+
+	failure := errors.New("failure")
+	dbmock.ExpectBegin()
+	dbmock.ExpectRollback()
+	err = sess.RunInTx(context.Background(), nil, func(tx *Tx) error {
+		return failure
+	})
+	assert.Equal(t, failure, err)
+
+	dbmock.ExpectBegin()
+	dbmock.ExpectRollback()
+	assert.Panics(t, func() {
+		sess.RunInTx(context.Background(), nil, func(tx *Tx) error {
+			panic("failure")
+		})
+	})
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestNestedTransaction(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	recv := &testEventReceiver{}
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: recv}
+	sess := conn.NewSession(nil)
+
+	dbmock.ExpectBegin()
+	dbmock.ExpectExec(`SAVEPOINT "dbr_savepoint_1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`SAVEPOINT "dbr_savepoint_2"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`ROLLBACK TO SAVEPOINT "dbr_savepoint_2"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`RELEASE SAVEPOINT "dbr_savepoint_1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectCommit()
+
+	tx, err := sess.Begin()
+	assert.NoError(t, err)
+	defer tx.RollbackUnlessCommitted()
+
+	inner, err := tx.Begin()
+	assert.NoError(t, err)
+	defer inner.RollbackUnlessCommitted()
+
+	innermost, err := inner.Begin()
+	assert.NoError(t, err)
+	innermost.RollbackUnlessCommitted()
+	assert.Equal(t, sql.ErrTxDone, innermost.Commit())
+
+	assert.NoError(t, inner.Commit())
+	assert.NoError(t, tx.Commit())
+
+	assert.Equal(t, []string{
+		"dbr.begin",
+		"dbr.savepoint",
+		"dbr.savepoint",
+		"dbr.rollback_to",
+		"dbr.release",
+		"dbr.commit",
+	}, recv.events)
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestNestedTransactionSiblings(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: nullReceiver}
+	sess := conn.NewSession(nil)
+
+	dbmock.ExpectBegin()
+	dbmock.ExpectExec(`SAVEPOINT "dbr_savepoint_1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`SAVEPOINT "dbr_savepoint_2"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`SAVEPOINT "dbr_savepoint_3"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`ROLLBACK TO SAVEPOINT "dbr_savepoint_1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`RELEASE SAVEPOINT "dbr_savepoint_2"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectCommit()
+
+	tx, err := sess.Begin()
+	assert.NoError(t, err)
+	first, err := tx.Begin()
+	assert.NoError(t, err)
+	second, err := tx.Begin()
+	assert.NoError(t, err)
+	// savepoint names are unique within the outermost transaction
+	_, err = second.Begin()
+	assert.NoError(t, err)
+
+	assert.NoError(t, first.Rollback())
+	assert.NoError(t, second.Commit())
+	assert.NoError(t, tx.Commit())
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestSavepointNotSupported(t *testing.T) {
+	tx := &Tx{EventReceiver: nullReceiver, Dialect: dialect.ClickHouse}
+	assert.Equal(t, ErrNotSupported, tx.Savepoint("a"))
+	assert.Equal(t, ErrNotSupported, tx.RollbackTo("a"))
+	assert.Equal(t, ErrNotSupported, tx.Release("a"))
+	_, err := tx.Begin()
+	assert.Equal(t, ErrNotSupported, err)
+}
+
+func TestTransactionHooks(t *testing.T) {
+	db, dbmock, err := sqlmock.New()
+	assert.NoError(t, err)
+	recv := &testEventReceiver{}
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: recv}
+	sess := conn.NewSession(nil)
+
+	var calls []string
+	hook := func(name string) func() {
+		return func() {
+			calls = append(calls, name)
+		}
+	}
+
+	dbmock.ExpectBegin()
+	dbmock.ExpectExec(`SAVEPOINT "dbr_savepoint_1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`RELEASE SAVEPOINT "dbr_savepoint_1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`SAVEPOINT "dbr_savepoint_2"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectExec(`ROLLBACK TO SAVEPOINT "dbr_savepoint_2"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbmock.ExpectCommit()
+
+	tx, err := sess.Begin()
+	assert.NoError(t, err)
+	tx.OnCommit(hook("commit 1"))
+	tx.OnRollback(hook("rollback 1"))
+	tx.OnCommit(func() {
+		panic("failure")
+	})
+
+	inner, err := tx.Begin()
+	assert.NoError(t, err)
+	inner.OnCommit(hook("inner commit"))
+	assert.NoError(t, inner.Commit())
+
+	rolledBack, err := tx.Begin()
+	assert.NoError(t, err)
+	rolledBack.OnCommit(hook("rolled back commit"))
+	rolledBack.OnRollback(hook("rolled back rollback"))
+	assert.NoError(t, rolledBack.Rollback())
+	assert.Equal(t, []string{"rolled back rollback"}, calls)
+
+	tx.OnCommit(hook("commit 2"))
+	assert.NoError(t, tx.Commit())
+	tx.RollbackUnlessCommitted()
+
+	assert.Equal(t, []string{"rolled back rollback", "commit 1", "inner commit", "commit 2"}, calls)
+	assert.Contains(t, recv.events, "dbr.tx.hook.panic")
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+
+	calls = nil
+	dbmock.ExpectBegin()
+	dbmock.ExpectRollback()
+	tx, err = sess.Begin()
+	assert.NoError(t, err)
+	tx.OnCommit(hook("commit"))
+	tx.OnRollback(hook("rollback"))
+	tx.RollbackUnlessCommitted()
+	assert.Equal(t, []string{"rollback"}, calls)
+	assert.NoError(t, dbmock.ExpectationsWereMet())
+}
+
+func TestTransactionCanceledContextHooks(t *testing.T) {
+	for _, rollback := range []func(tx *Tx){
+		func(tx *Tx) { tx.Rollback() },
+		func(tx *Tx) { tx.RollbackUnlessCommitted() },
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		tx, err := sqlite3Session.beginWithContext(ctx, nil)
+		assert.NoError(t, err)
+		calls := 0
+		tx.OnRollback(func() {
+			calls++
+		})
+
+		// database/sql rolls back the transaction on cancel
+		cancel()
+		assert.Eventually(t, func() bool {
+			_, err := tx.Tx.Exec("SELECT 1")
+			return err == sql.ErrTxDone
+		}, time.Second, time.Millisecond)
+		rollback(tx)
+		rollback(tx)
+		assert.Equal(t, 1, calls)
+	}
+
+	calls := 0
+	ctx, cancel := context.WithCancel(context.Background())
+	err := sqlite3Session.RunInTx(ctx, nil, func(tx *Tx) error {
+		tx.OnRollback(func() {
+			calls++
+		})
+		cancel()
+		_, err := tx.Select("*").From("dbr_people").ReturnInt64s()
+		return err
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
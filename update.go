@@ -1,6 +1,9 @@
 package dbr
 
-import "reflect"
+import (
+	"reflect"
+	"sort"
+)
 
 // UpdateStmt builds `UPDATE ...`
 type UpdateStmt interface {
@@ -10,14 +13,26 @@ type UpdateStmt interface {
 	Set(column string, value interface{}) UpdateStmt
 	SetMap(m map[string]interface{}) UpdateStmt
 	SetRecord(structValue interface{}) UpdateStmt
+	SetExpr(column string, query string, value ...interface{}) UpdateStmt
+	SetDefault(column string) UpdateStmt
+	SetNull(column string) UpdateStmt
+	Incr(column string, n interface{}) UpdateStmt
+	Decr(column string, n interface{}) UpdateStmt
+	With(name string, builder Builder) UpdateStmt
+	WithRecursive(name string, builder Builder) UpdateStmt
+	Returning(column ...string) UpdateStmt
 }
 
 type updateStmt struct {
 	raw
 
+	CTE       []*cte
 	Table     string
+	Column    []string
 	Value     map[string]interface{}
 	WhereCond []Builder
+
+	ReturnColumn []string
 }
 
 // Build builds `UPDATE ...` in dialect
@@ -30,16 +45,20 @@ func (b *updateStmt) Build(d Dialect, buf Buffer) error {
 		return ErrTableNotSpecified
 	}
 
-	if len(b.Value) == 0 {
+	if len(b.Column) == 0 {
 		return ErrColumnNotSpecified
 	}
 
+	err := buildWith(d, buf, b.CTE)
+	if err != nil {
+		return err
+	}
+
 	buf.WriteString("UPDATE ")
 	buf.WriteString(d.QuoteIdent(b.Table))
 	buf.WriteString(" SET ")
 
-	i := 0
-	for col, v := range b.Value {
+	for i, col := range b.Column {
 		if i > 0 {
 			buf.WriteString(", ")
 		}
@@ -47,18 +66,17 @@ func (b *updateStmt) Build(d Dialect, buf Buffer) error {
 		buf.WriteString(" = ")
 		buf.WriteString(placeholder)
 
-		buf.WriteValue(v)
-		i++
+		buf.WriteValue(b.Value[col])
 	}
 
 	if len(b.WhereCond) > 0 {
 		buf.WriteString(" WHERE ")
-		err := And(b.WhereCond...).Build(d, buf)
+		err = And(b.WhereCond...).Build(d, buf)
 		if err != nil {
 			return err
 		}
 	}
-	return nil
+	return buildReturning(d, buf, b.ReturnColumn)
 }
 
 // Update creates an UpdateStmt
@@ -99,31 +117,87 @@ func (b *updateStmt) Where(query interface{}, value ...interface{}) UpdateStmt {
 	return b
 }
 
-// Set specifies a key-value pair
+// Set specifies a key-value pair, columns are set in order of the first Set call for them
 func (b *updateStmt) Set(column string, value interface{}) UpdateStmt {
+	if _, ok := b.Value[column]; !ok {
+		b.Column = append(b.Column, column)
+	}
 	b.Value[column] = value
 	return b
 }
 
-// SetMap specifies a list of key-value pair
+// SetMap specifies a list of key-value pair, columns are set in sorted order
 func (b *updateStmt) SetMap(m map[string]interface{}) UpdateStmt {
-	for col, val := range m {
-		b.Set(col, val)
+	column := make([]string, 0, len(m))
+	for col := range m {
+		column = append(column, col)
+	}
+	sort.Strings(column)
+	for _, col := range column {
+		b.Set(col, m[col])
 	}
 	return b
 }
 
-// SetRecord specifies a record with field and values to set
+// SetRecord specifies a record with field and values to set, columns are set in sorted order
 func (b *updateStmt) SetRecord(structValue interface{}) UpdateStmt {
 	v := reflect.Indirect(reflect.ValueOf(structValue))
 
 	if v.Kind() == reflect.Struct {
 		sm := structMap(v.Type())
 
-		for col, index := range sm {
-			b.Set(col, v.FieldByIndex(index).Interface())
+		column := make([]string, 0, len(sm))
+		for col := range sm {
+			column = append(column, col)
+		}
+		sort.Strings(column)
+		for _, col := range column {
+			b.Set(col, v.FieldByIndex(sm[col]).Interface())
 		}
 	}
 
 	return b
 }
+
+// SetExpr sets column to expression, e.g. `SetExpr("total", "price * ?", n)`
+func (b *updateStmt) SetExpr(column string, query string, value ...interface{}) UpdateStmt {
+	return b.Set(column, Expr(query, value...))
+}
+
+// SetDefault sets column to its default value
+func (b *updateStmt) SetDefault(column string) UpdateStmt {
+	return b.Set(column, Default())
+}
+
+// SetNull sets column to NULL
+func (b *updateStmt) SetNull(column string) UpdateStmt {
+	return b.Set(column, nil)
+}
+
+// Incr increments column by n, `column = column + n`
+func (b *updateStmt) Incr(column string, n interface{}) UpdateStmt {
+	return b.Set(column, Incr(column, n))
+}
+
+// Decr decrements column by n, `column = column - n`
+func (b *updateStmt) Decr(column string, n interface{}) UpdateStmt {
+	return b.Set(column, Decr(column, n))
+}
+
+// With adds a common table expression `WITH name AS (...)`
+func (b *updateStmt) With(name string, builder Builder) UpdateStmt {
+	b.CTE = append(b.CTE, &cte{name: name, builder: builder})
+	return b
+}
+
+// WithRecursive adds a recursive common table expression `WITH RECURSIVE name AS (...)`
+func (b *updateStmt) WithRecursive(name string, builder Builder) UpdateStmt {
+	b.CTE = append(b.CTE, &cte{name: name, builder: builder, recursive: true})
+	return b
+}
+
+// Returning adds `RETURNING` clause, columns are loaded via Load* methods of UpdateBuilder
+func (b *updateStmt) Returning(column ...string) UpdateStmt {
+	b.ReturnColumn = append(b.ReturnColumn, column...)
+	return b
+}
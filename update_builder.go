@@ -11,11 +11,20 @@ type UpdateBuilder interface {
 	Builder
 	EventReceiver
 	Executer
+	returningLoader
 
 	Where(query interface{}, value ...interface{}) UpdateBuilder
 	Set(column string, value interface{}) UpdateBuilder
 	SetMap(m map[string]interface{}) UpdateBuilder
+	SetExpr(column string, query string, value ...interface{}) UpdateBuilder
+	SetDefault(column string) UpdateBuilder
+	SetNull(column string) UpdateBuilder
+	Incr(column string, n interface{}) UpdateBuilder
+	Decr(column string, n interface{}) UpdateBuilder
 	Limit(n uint64) UpdateBuilder
+	With(name string, builder Builder) UpdateBuilder
+	WithRecursive(name string, builder Builder) UpdateBuilder
+	Returning(column ...string) UpdateBuilder
 }
 
 type updateBuilder struct {
@@ -98,12 +107,54 @@ func (b *updateBuilder) SetMap(m map[string]interface{}) UpdateBuilder {
 	return b
 }
 
+// SetExpr adds "SET column=expression"
+func (b *updateBuilder) SetExpr(column string, query string, value ...interface{}) UpdateBuilder {
+	b.updateStmt.SetExpr(column, query, value...)
+	return b
+}
+
+// SetDefault adds "SET column=DEFAULT"
+func (b *updateBuilder) SetDefault(column string) UpdateBuilder {
+	b.updateStmt.SetDefault(column)
+	return b
+}
+
+// SetNull adds "SET column=NULL"
+func (b *updateBuilder) SetNull(column string) UpdateBuilder {
+	b.updateStmt.SetNull(column)
+	return b
+}
+
+// Incr adds "SET column=column+n"
+func (b *updateBuilder) Incr(column string, n interface{}) UpdateBuilder {
+	b.updateStmt.Incr(column, n)
+	return b
+}
+
+// Decr adds "SET column=column-n"
+func (b *updateBuilder) Decr(column string, n interface{}) UpdateBuilder {
+	b.updateStmt.Decr(column, n)
+	return b
+}
+
 // Where adds condition to the stmt
 func (b *updateBuilder) Where(query interface{}, value ...interface{}) UpdateBuilder {
 	b.updateStmt.Where(query, value...)
 	return b
 }
 
+// With adds a common table expression `WITH name AS (...)`
+func (b *updateBuilder) With(name string, builder Builder) UpdateBuilder {
+	b.updateStmt.With(name, builder)
+	return b
+}
+
+// WithRecursive adds a recursive common table expression `WITH RECURSIVE name AS (...)`
+func (b *updateBuilder) WithRecursive(name string, builder Builder) UpdateBuilder {
+	b.updateStmt.WithRecursive(name, builder)
+	return b
+}
+
 // Limit adds LIMIT
 func (b *updateBuilder) Limit(n uint64) UpdateBuilder {
 	b.LimitCount = int64(n)
@@ -122,3 +173,46 @@ func (b *updateBuilder) Build(d Dialect, buf Buffer) error {
 	}
 	return nil
 }
+
+// Returning adds `RETURNING` clause
+func (b *updateBuilder) Returning(column ...string) UpdateBuilder {
+	b.updateStmt.Returning(column...)
+	return b
+}
+
+// Load loads any value returned by `RETURNING` clause with background context
+func (b *updateBuilder) Load(value interface{}) (int, error) {
+	return b.LoadContext(b.ctx, value)
+}
+
+// LoadContext loads any value returned by `RETURNING` clause
+func (b *updateBuilder) LoadContext(ctx context.Context, value interface{}) (int, error) {
+	return query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+}
+
+// LoadStruct loads struct returned by `RETURNING` clause with background context, returns ErrNotFound if there is no result
+func (b *updateBuilder) LoadStruct(value interface{}) error {
+	return b.LoadStructContext(b.ctx, value)
+}
+
+// LoadStructContext loads struct returned by `RETURNING` clause, returns ErrNotFound if there is no result
+func (b *updateBuilder) LoadStructContext(ctx context.Context, value interface{}) error {
+	count, err := query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// LoadStructs loads structures returned by `RETURNING` clause with background context
+func (b *updateBuilder) LoadStructs(value interface{}) (int, error) {
+	return b.LoadStructsContext(b.ctx, value)
+}
+
+// LoadStructsContext loads structures returned by `RETURNING` clause
+func (b *updateBuilder) LoadStructsContext(ctx context.Context, value interface{}) (int, error) {
+	return query(ctx, b.runner, b.EventReceiver, b, b.Dialect, value)
+}
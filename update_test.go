@@ -28,6 +28,86 @@ func TestUpdateStmtSetRecord(t *testing.T) {
 	assert.Equal(t, []interface{}{1, 2}, buf.Value())
 }
 
+func TestUpdateStmtColumnOrder(t *testing.T) {
+	record := struct {
+		C int
+		B int
+	}{C: 3, B: 2}
+	for _, test := range []struct {
+		stmt  UpdateStmt
+		query string
+		value []interface{}
+	}{
+		{
+			stmt:  Update("table").Set("c", 3).Set("a", 1).Set("b", 2),
+			query: "UPDATE `table` SET `c` = ?, `a` = ?, `b` = ?",
+			value: []interface{}{3, 1, 2},
+		},
+		{
+			stmt:  Update("table").Set("c", 3).Set("a", 1).Set("c", 4),
+			query: "UPDATE `table` SET `c` = ?, `a` = ?",
+			value: []interface{}{4, 1},
+		},
+		{
+			stmt:  Update("table").Set("d", 4).SetMap(map[string]interface{}{"c": 3, "a": 1, "b": 2, "d": 5}),
+			query: "UPDATE `table` SET `d` = ?, `a` = ?, `b` = ?, `c` = ?",
+			value: []interface{}{5, 1, 2, 3},
+		},
+		{
+			stmt:  Update("table").SetRecord(&record),
+			query: "UPDATE `table` SET `b` = ?, `c` = ?",
+			value: []interface{}{2, 3},
+		},
+	} {
+		buf := NewBuffer()
+		err := test.stmt.Build(dialect.MySQL, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, buf.String())
+		assert.Equal(t, test.value, buf.Value())
+	}
+}
+
+func TestUpdateStmtSetExpr(t *testing.T) {
+	buf := NewBuffer()
+	builder := Update("counters").
+		Incr("hits", 1).
+		Decr("credits", 2.5).
+		SetExpr("total", "price * ?", 3).
+		SetDefault("status").
+		SetNull("deleted_at").
+		Where(Eq("id", 4))
+	err := builder.Build(dialect.PostgreSQL, buf)
+	assert.NoError(t, err)
+
+	query, err := InterpolateForDialect(buf.String(), buf.Value(), dialect.PostgreSQL)
+	assert.NoError(t, err)
+	assert.Equal(t, `UPDATE "counters" SET "hits" = "hits" + 1, "credits" = "credits" - 2.5, "total" = price * 3, `+
+		`"status" = DEFAULT, "deleted_at" = NULL WHERE ("id" = 4)`, query)
+}
+
+func TestUpdateStmtWith(t *testing.T) {
+	buf := NewBuffer()
+	builder := Update("table").
+		With("stale", Select("id").From("table").Where(Lt("updated_at", 10))).
+		Set("a", 1).
+		Where("id IN ?", Select("id").From("stale"))
+	err := builder.Build(dialect.PostgreSQL, buf)
+	assert.NoError(t, err)
+
+	assert.Equal(t, `WITH "stale" AS (SELECT id FROM table WHERE ("updated_at" < ?)) UPDATE "table" SET "a" = ? WHERE (id IN ?)`, buf.String())
+	assert.Equal(t, 3, len(buf.Value()))
+}
+
+func TestUpdateStmtReturning(t *testing.T) {
+	buf := NewBuffer()
+	builder := Update("table").Set("a", 1).Where(Eq("b", 2)).Returning("*")
+	err := builder.Build(dialect.SQLite3, buf)
+	assert.NoError(t, err)
+
+	assert.Equal(t, `UPDATE "table" SET "a" = ? WHERE ("b" = ?) RETURNING *`, buf.String())
+	assert.Equal(t, []interface{}{1, 2}, buf.Value())
+}
+
 func BenchmarkUpdateValuesSQL(b *testing.B) {
 	buf := NewBuffer()
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,178 @@
+package dbr
+
+import "strconv"
+
+// FrameBound is a bound of window frame
+type FrameBound string
+
+// window frame bounds
+const (
+	UnboundedPreceding FrameBound = "UNBOUNDED PRECEDING"
+	CurrentRow         FrameBound = "CURRENT ROW"
+	UnboundedFollowing FrameBound = "UNBOUNDED FOLLOWING"
+)
+
+// Preceding is `n PRECEDING` frame bound
+func Preceding(n uint64) FrameBound {
+	return FrameBound(strconv.FormatUint(n, 10) + " PRECEDING")
+}
+
+// Following is `n FOLLOWING` frame bound
+func Following(n uint64) FrameBound {
+	return FrameBound(strconv.FormatUint(n, 10) + " FOLLOWING")
+}
+
+// WindowBuilder builds window function call `fn OVER (...)` or window specification
+type WindowBuilder interface {
+	Builder
+
+	Window(name string) WindowBuilder
+	PartitionBy(col ...string) WindowBuilder
+	OrderAsc(col string) WindowBuilder
+	OrderDesc(col string) WindowBuilder
+	Rows(start, end FrameBound) WindowBuilder
+	Range(start, end FrameBound) WindowBuilder
+	As(alias string) Builder
+}
+
+type window struct {
+	fn        Builder
+	name      string
+	partition []string
+	order     []*orderBy
+	frame     string
+	start     FrameBound
+	end       FrameBound
+}
+
+// Over creates window function call `fn OVER (...)`
+func Over(fn Builder) WindowBuilder {
+	return &window{fn: fn}
+}
+
+// NewWindow creates window specification for SelectStmt.Window
+func NewWindow() WindowBuilder {
+	return &window{}
+}
+
+// Build builds `fn OVER (...)` or `(...)` if there is no function
+func (w *window) Build(d Dialect, buf Buffer) error {
+	if w.fn != nil {
+		err := w.fn.Build(d, buf)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(" OVER ")
+		if w.name != "" && len(w.partition) == 0 && len(w.order) == 0 && w.frame == "" {
+			buf.WriteString(d.QuoteIdent(w.name))
+			return nil
+		}
+	}
+
+	buf.WriteString("(")
+	sep := ""
+	if w.name != "" {
+		buf.WriteString(d.QuoteIdent(w.name))
+		sep = " "
+	}
+	if len(w.partition) > 0 {
+		buf.WriteString(sep)
+		buf.WriteString("PARTITION BY ")
+		for i, col := range w.partition {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(d.QuoteIdent(col))
+		}
+		sep = " "
+	}
+	if len(w.order) > 0 {
+		buf.WriteString(sep)
+		buf.WriteString("ORDER BY ")
+		for i, o := range w.order {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			err := order(d.QuoteIdent(o.column), o.dir).Build(d, buf)
+			if err != nil {
+				return err
+			}
+		}
+		sep = " "
+	}
+	if w.frame != "" {
+		buf.WriteString(sep)
+		buf.WriteString(w.frame)
+		buf.WriteString(" BETWEEN ")
+		buf.WriteString(string(w.start))
+		buf.WriteString(" AND ")
+		buf.WriteString(string(w.end))
+	}
+	buf.WriteString(")")
+	return nil
+}
+
+// Window bases the window on the named window defined by SelectStmt.Window
+func (w *window) Window(name string) WindowBuilder {
+	w.name = name
+	return w
+}
+
+// PartitionBy adds `PARTITION BY`
+func (w *window) PartitionBy(col ...string) WindowBuilder {
+	w.partition = append(w.partition, col...)
+	return w
+}
+
+// OrderAsc specifies columns for ordering in asc direction
+func (w *window) OrderAsc(col string) WindowBuilder {
+	w.order = append(w.order, &orderBy{column: col, dir: asc})
+	return w
+}
+
+// OrderDesc specifies columns for ordering in desc direction
+func (w *window) OrderDesc(col string) WindowBuilder {
+	w.order = append(w.order, &orderBy{column: col, dir: desc})
+	return w
+}
+
+// Rows adds `ROWS BETWEEN start AND end` frame
+func (w *window) Rows(start, end FrameBound) WindowBuilder {
+	w.frame, w.start, w.end = "ROWS", start, end
+	return w
+}
+
+// Range adds `RANGE BETWEEN start AND end` frame
+func (w *window) Range(start, end FrameBound) WindowBuilder {
+	w.frame, w.start, w.end = "RANGE", start, end
+	return w
+}
+
+// As creates alias for window function call
+func (w *window) As(alias string) Builder {
+	return as(w, alias)
+}
+
+type windowDef struct {
+	name string
+	spec WindowBuilder
+}
+
+func buildWindow(d Dialect, buf Buffer, window []*windowDef) error {
+	if len(window) == 0 {
+		return nil
+	}
+	buf.WriteString(" WINDOW ")
+	for i, w := range window {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(d.QuoteIdent(w.name))
+		buf.WriteString(" AS ")
+		err := w.spec.Build(d, buf)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package dbr
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mailru/dbr/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindow(t *testing.T) {
+	for _, test := range []struct {
+		builder Builder
+		query   string
+	}{
+		{
+			builder: Over(Expr("ROW_NUMBER()")),
+			query:   "ROW_NUMBER() OVER ()",
+		},
+		{
+			builder: Over(Expr("ROW_NUMBER()")).PartitionBy("user_id").OrderDesc("created_at").As("n"),
+			query:   "ROW_NUMBER() OVER (PARTITION BY `user_id` ORDER BY `created_at` DESC) AS `n`",
+		},
+		{
+			builder: Over(Expr("SUM(amount)")).PartitionBy("a", "b").OrderAsc("id").Rows(UnboundedPreceding, CurrentRow),
+			query:   "SUM(amount) OVER (PARTITION BY `a`, `b` ORDER BY `id` ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)",
+		},
+		{
+			builder: Over(Expr("AVG(price)")).OrderAsc("day").Range(Preceding(7), Following(1)),
+			query:   "AVG(price) OVER (ORDER BY `day` ASC RANGE BETWEEN 7 PRECEDING AND 1 FOLLOWING)",
+		},
+		{
+			builder: Over(Expr("RANK()")).Window("w"),
+			query:   "RANK() OVER `w`",
+		},
+		{
+			builder: Over(Expr("SUM(?)", 1)).Window("w").Rows(Preceding(1), CurrentRow),
+			query:   "SUM(1) OVER (`w` ROWS BETWEEN 1 PRECEDING AND CURRENT ROW)",
+		},
+		{
+			builder: Select("id", Over(Expr("RANK()")).Window("w").As("r")).From("t").
+				Window("w", NewWindow().PartitionBy("user_id").OrderDesc("score")).OrderAsc("id"),
+			query: "SELECT id, RANK() OVER `w` AS `r` FROM t WINDOW `w` AS (PARTITION BY `user_id` ORDER BY `score` DESC) ORDER BY id ASC",
+		},
+	} {
+		buf := NewBuffer()
+		err := test.builder.Build(dialect.MySQL, buf)
+		assert.NoError(t, err)
+		query, err := InterpolateForDialect(buf.String(), buf.Value(), dialect.MySQL)
+		assert.NoError(t, err)
+		assert.Equal(t, test.query, query)
+	}
+}
+
+func TestWindowColumns(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	conn := &Connection{DBConn: db, Dialect: dialect.PostgreSQL, EventReceiver: nullReceiver}
+	sess := conn.NewSession(nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, ROW_NUMBER() OVER (PARTITION BY "user_id" ORDER BY "id" ASC) AS "n" FROM suggestions`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "n"}).AddRow(1, 1).AddRow(2, 2))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT RANK() OVER "w" FROM suggestions WINDOW "w" AS (ORDER BY "id" ASC)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow(1))
+
+	var rows []struct {
+		ID int64
+		N  int64
+	}
+	_, err = sess.Select("id").
+		Columns(Over(Expr("ROW_NUMBER()")).PartitionBy("user_id").OrderAsc("id").As("n")).
+		From("suggestions").
+		Load(&rows)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+
+	tx, err := sess.Begin()
+	assert.NoError(t, err)
+	rank, err := tx.Select().Columns(Over(Expr("RANK()")).Window("w")).From("suggestions").
+		Window("w", NewWindow().OrderAsc("id")).ReturnInt64()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), rank)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,37 @@
+package dbr
+
+type cte struct {
+	name      string
+	builder   Builder
+	recursive bool
+}
+
+// buildWith builds `WITH [RECURSIVE] name AS (...), ...` prefix of a statement
+func buildWith(d Dialect, buf Buffer, with []*cte) error {
+	if len(with) == 0 {
+		return nil
+	}
+
+	buf.WriteString("WITH ")
+	for _, c := range with {
+		if c.recursive {
+			buf.WriteString("RECURSIVE ")
+			break
+		}
+	}
+
+	for i, c := range with {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(d.QuoteIdent(c.name))
+		buf.WriteString(" AS (")
+		err := c.builder.Build(d, buf)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(")")
+	}
+	buf.WriteString(" ")
+	return nil
+}